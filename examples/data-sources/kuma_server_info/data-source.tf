@@ -0,0 +1,6 @@
+# Read the version of the connected Uptime Kuma server
+data "kuma_server_info" "current" {}
+
+output "kuma_version" {
+  value = data.kuma_server_info.current.version
+}
@@ -0,0 +1,19 @@
+resource "kuma_dns_monitor" "example" {
+  name            = "mail exchangers"
+  hostname        = "example.com"
+  record_type     = "MX"
+  resolver_server = "1.1.1.1"
+  port            = 53
+
+  # Uptime Kuma 2.0 and later only.
+  conditions = [
+    {
+      operator = "contains"
+      value    = "mx1.example.com"
+    },
+  ]
+}
+
+output "mail_records" {
+  value = kuma_dns_monitor.example.last_result
+}
@@ -0,0 +1,16 @@
+resource "kuma_docker_host" "local" {
+  name          = "local"
+  docker_daemon = "/var/run/docker.sock"
+}
+
+resource "kuma_docker_container_monitor" "example" {
+  name             = "nginx"
+  docker_container = "nginx"
+  docker_host      = kuma_docker_host.local.id
+
+  interval    = 30
+  max_retries = 3
+  tags = {
+    env = "prod"
+  }
+}
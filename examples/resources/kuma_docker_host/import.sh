@@ -0,0 +1,2 @@
+# Docker host can be imported by specifying the numeric docker host ID.
+terraform import kuma_docker_host.example 1
@@ -0,0 +1,9 @@
+resource "kuma_docker_host" "local" {
+  name          = "local"
+  docker_daemon = "/var/run/docker.sock"
+}
+
+resource "kuma_docker_host" "remote" {
+  name          = "build-server"
+  docker_daemon = "tcp://build.example.com:2375"
+}
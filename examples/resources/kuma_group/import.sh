@@ -1,2 +1,2 @@
-# Monitor can imported by specifying the identifier.
-terraform import kuma_group.example example
+# Monitor can be imported by specifying the numeric monitor ID.
+terraform import kuma_group.example 1
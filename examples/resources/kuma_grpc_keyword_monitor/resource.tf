@@ -0,0 +1,22 @@
+resource "kuma_grpc_keyword_monitor" "example" {
+  name              = "orders-health"
+  grpc_url          = "orders.example.com:443"
+  grpc_enable_tls   = true
+  grpc_protobuf     = file("${path.module}/health.proto")
+  grpc_service_name = "grpc.health.v1.Health"
+  grpc_method       = "Check"
+
+  grpc_body = {
+    service = "orders"
+  }
+  grpc_metadata = {
+    authorization = "Bearer ${var.orders_token}"
+  }
+
+  keyword = "SERVING"
+}
+
+variable "orders_token" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,14 @@
+resource "kuma_json_query_monitor" "example" {
+  name           = "health endpoint"
+  url            = "https://example.com/health"
+  json_query     = "status"
+  expected_value = "UP"
+
+  # Uptime Kuma 2.0 and later only.
+  json_query_operator = "=="
+
+  interval = 60
+  tags = {
+    env = "prod"
+  }
+}
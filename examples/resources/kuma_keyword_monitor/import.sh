@@ -0,0 +1,2 @@
+# Monitor can be imported by specifying the numeric monitor ID.
+terraform import kuma_keyword_monitor.example 2
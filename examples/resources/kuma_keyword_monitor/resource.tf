@@ -0,0 +1,11 @@
+resource "kuma_keyword_monitor" "example" {
+  name    = "status page"
+  url     = "https://example.com/status"
+  keyword = "All systems operational"
+
+  interval          = 60
+  notification_list = [1]
+  tags = {
+    env = "prod"
+  }
+}
@@ -0,0 +1,9 @@
+resource "kuma_ping_monitor" "example" {
+  name        = "core router"
+  hostname    = "10.0.0.1"
+  packet_size = 56
+
+  # Uptime Kuma 2.0 and later only.
+  ping_count               = 3
+  ping_per_request_timeout = 2
+}
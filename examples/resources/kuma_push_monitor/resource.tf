@@ -0,0 +1,14 @@
+resource "kuma_push_monitor" "example" {
+  name     = "nightly-backup"
+  interval = 86400
+
+  tags = {
+    env = "prod"
+  }
+}
+
+# Hand the URL to the job, which requests it when it succeeds.
+output "backup_push_url" {
+  value     = kuma_push_monitor.example.push_url
+  sensitive = true
+}
@@ -0,0 +1,4 @@
+resource "kuma_redis_monitor" "example" {
+  name              = "cache"
+  connection_string = "rediss://cache.example.com:6380"
+}
@@ -0,0 +1,10 @@
+variable "erp_db_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "kuma_sqlserver_monitor" "example" {
+  name              = "erp-db"
+  connection_string = "Server=mssql.example.com,1433;Database=erp;User Id=kuma;Password=${var.erp_db_password};Encrypt=true"
+  query             = "SELECT 1"
+}
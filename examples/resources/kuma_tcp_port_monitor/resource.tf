@@ -0,0 +1,16 @@
+resource "kuma_group" "databases" {
+  name = "databases"
+}
+
+resource "kuma_tcp_port_monitor" "example" {
+  name     = "postgres"
+  hostname = "db.example.com"
+  port     = 5432
+  parent   = kuma_group.databases.id
+
+  interval    = 30
+  max_retries = 3
+  tags = {
+    env = "prod"
+  }
+}
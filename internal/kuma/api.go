@@ -0,0 +1,70 @@
+package kuma
+
+import "context"
+
+//go:generate go run go.uber.org/mock/mockgen -destination=kumamock/api.go -package=kumamock . API
+
+// API is the set of Uptime Kuma operations used by the provider. Client
+// implements it for both backends.
+type API interface {
+	AuthAPI
+	MonitorAPI
+	MonitorTagAPI
+	TagAPI
+	NotificationAPI
+	ServerInfoAPI
+	DockerHostAPI
+}
+
+// AuthAPI signs in to Uptime Kuma.
+type AuthAPI interface {
+	SignIn(ctx context.Context) (*AuthResponse, error)
+}
+
+// MonitorAPI manages monitors.
+type MonitorAPI interface {
+	GetMonitors(ctx context.Context) ([]Monitor, error)
+	GetMonitor(ctx context.Context, id int64) (*Monitor, error)
+	CreateMonitor(ctx context.Context, monitor Monitor) (*int64, error)
+	UpdateMonitor(ctx context.Context, monitorID int64, monitor Monitor) error
+	DeleteMonitor(ctx context.Context, id int64) error
+	PushURL(ctx context.Context, token string) (string, error)
+}
+
+// MonitorTagAPI attaches tags to monitors and detaches them.
+type MonitorTagAPI interface {
+	CreateMonitorTag(ctx context.Context, monitorID int64, tagSet MonitorTag) error
+	DeleteMonitorTag(ctx context.Context, monitorID int64, tagSet MonitorTag) error
+}
+
+// TagAPI manages tags.
+type TagAPI interface {
+	GetTags(ctx context.Context) ([]Tag, error)
+	GetTag(ctx context.Context, tagName string) (*Tag, error)
+	CreateTag(ctx context.Context, tag Tag) (*Tag, error)
+	UpdateTag(ctx context.Context, tagId int64, tagInfo Tag) error
+	DeleteTag(ctx context.Context, tagId int64) error
+}
+
+// NotificationAPI reads notifications.
+type NotificationAPI interface {
+	GetNotifications(ctx context.Context) ([]Notification, error)
+	GetNotification(ctx context.Context, id int64) (*Notification, error)
+	GetDefaultNotifications(ctx context.Context) ([]int64, error)
+}
+
+// DockerHostAPI manages docker hosts.
+type DockerHostAPI interface {
+	GetDockerHosts(ctx context.Context) ([]DockerHost, error)
+	GetDockerHost(ctx context.Context, id int64) (*DockerHost, error)
+	CreateDockerHost(ctx context.Context, host DockerHost) (*DockerHost, error)
+	UpdateDockerHost(ctx context.Context, id int64, host DockerHost) error
+	DeleteDockerHost(ctx context.Context, id int64) error
+}
+
+// ServerInfoAPI describes the connected server.
+type ServerInfoAPI interface {
+	GetServerInfo(ctx context.Context) (*ServerInfo, error)
+}
+
+var _ API = (*Client)(nil)
@@ -1,22 +1,41 @@
 package kuma
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 )
 
-func (c *Client) SignIn() (*AuthResponse, error) {
+func (c *Client) SignIn(ctx context.Context) (*AuthResponse, error) {
 	if c.Auth.Username == "" || c.Auth.Password == "" {
 		return nil, fmt.Errorf("define username and password")
 	}
 
-	readerBody := strings.NewReader(fmt.Sprintf("username=%s&password=%s", c.Auth.Username, c.Auth.Password))
+	form := url.Values{}
+	form.Set("username", c.Auth.Username)
+	form.Set("password", c.Auth.Password)
 
-	body, _, err := c.doRequest("POST", "/login/access-token/", readerBody, withContentType("application/x-www-form-urlencoded"))
+	code, err := c.Auth.totpCode(time.Now())
 	if err != nil {
 		return nil, err
 	}
+	if code != "" {
+		form.Set("token", code)
+	}
+
+	readerBody := strings.NewReader(form.Encode())
+
+	body, _, err := c.doRequest(ctx, "POST", "/login/access-token/", readerBody, withContentType("application/x-www-form-urlencoded"), withoutReauth(), withIdempotent())
+	if err != nil {
+		if code == "" && isTOTPRequired(err) {
+			return nil, ErrTOTPRequired
+		}
+		return nil, err
+	}
 
 	ar := AuthResponse{}
 	err = json.Unmarshal(body, &ar)
@@ -24,5 +43,60 @@ func (c *Client) SignIn() (*AuthResponse, error) {
 		return nil, err
 	}
 
+	if ar.TokenRequired {
+		if code == "" {
+			return nil, ErrTOTPRequired
+		}
+		return nil, fmt.Errorf("two-factor code was rejected")
+	}
+
 	return &ar, nil
 }
+
+// isTOTPRequired reports whether a failed sign-in was rejected because the
+// account has two-factor authentication enabled.
+func isTOTPRequired(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	detail := strings.ToLower(apiErr.Detail)
+
+	return strings.Contains(detail, "tokenrequired") || strings.Contains(detail, "2fa") || strings.Contains(detail, "two-factor")
+}
+
+func (c *Client) token() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+
+	return c.Token
+}
+
+func (c *Client) canReauth() bool {
+	return c.Auth.Username != "" && c.Auth.Password != ""
+}
+
+// refreshToken signs in again after staleToken was rejected. Concurrent
+// callers holding the same stale token wait for a single sign-in and share
+// its result.
+func (c *Client) refreshToken(ctx context.Context, staleToken string) (string, error) {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	if token := c.token(); token != staleToken {
+		// Another request already refreshed the token.
+		return token, nil
+	}
+
+	ar, err := c.SignIn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("refresh access token: %w", err)
+	}
+
+	c.tokenMu.Lock()
+	c.Token = ar.Token
+	c.tokenMu.Unlock()
+
+	return ar.Token, nil
+}
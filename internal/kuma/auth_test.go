@@ -0,0 +1,185 @@
+package kuma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRefreshesExpiredToken(t *testing.T) {
+	var signIns atomic.Int32
+	var mu sync.Mutex
+	validToken := "token-0"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/login/access-token/" {
+			validToken = fmt.Sprintf("token-%d", signIns.Add(1))
+			fmt.Fprintf(w, `{"access_token": %q}`, validToken)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPost && string(body) != `{"id":0,"name":"tag","color":"#fff"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"tags": []}`))
+	}))
+	defer server.Close()
+
+	username, password := "admin", "admin"
+	client, err := NewClient(context.Background(), &server.URL, &username, &password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Expire the token on the server side.
+	mu.Lock()
+	validToken = "expired"
+	mu.Unlock()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetTags(context.Background()); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// One sign-in from NewClient, one shared refresh.
+	if got := signIns.Load(); got != 2 {
+		t.Fatalf("expected 2 sign-ins, got %d", got)
+	}
+
+	// The request body is replayed after refreshing.
+	mu.Lock()
+	validToken = "expired"
+	mu.Unlock()
+
+	if _, err := client.CreateTag(context.Background(), Tag{Name: "tag", Color: "#fff"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDoRequestReauthDoesNotUseRetry(t *testing.T) {
+	var calls atomic.Int32
+	validToken := "token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login/access-token/" {
+			fmt.Fprintf(w, `{"access_token": %q}`, validToken)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"tags": []}`))
+	}))
+	defer server.Close()
+
+	username, password := "admin", "admin"
+	client, err := NewClient(context.Background(), &server.URL, &username, &password, WithRetry(1, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Expire the token on the client side, the server answers 401 once.
+	client.Token = "expired"
+
+	// 401, sign in, 503, retry, 200.
+	if _, err := client.GetTags(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 authenticated calls, got %d", got)
+	}
+}
+
+func TestSignInEncodesCredentials(t *testing.T) {
+	username, password := "admin+ops@example.com", "p&ss=w+rd %20?"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if r.PostForm.Get("username") != username || r.PostForm.Get("password") != password {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail": "Incorrect username or password"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"access_token": "token"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, &username, &password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if client.Token != "token" {
+		t.Fatalf("expected token to be set, got %q", client.Token)
+	}
+}
+
+func TestSignInTOTPRequired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+
+		if r.PostForm.Get("token") == "" {
+			_, _ = w.Write([]byte(`{"tokenRequired": true}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"access_token": "token"}`))
+	}))
+	defer server.Close()
+
+	username, password := "admin", "admin"
+
+	_, err := NewClient(context.Background(), &server.URL, &username, &password)
+	if !errors.Is(err, ErrTOTPRequired) {
+		t.Fatalf("expected ErrTOTPRequired, got %v", err)
+	}
+
+	if _, err := NewClient(context.Background(), &server.URL, &username, &password, WithTOTP("JBSWY3DPEHPK3PXP", "")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,132 @@
+package kuma
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheTTL is how long list responses are reused. It is meant to cover
+// a single Terraform run, where many resources look up the same tags,
+// notifications and monitors.
+const DefaultCacheTTL = 30 * time.Second
+
+const (
+	cacheKeyTags          = "tags"
+	cacheKeyNotifications = "notifications"
+	cacheKeyMonitors      = "monitors"
+	cacheKeyDockerHosts   = "dockerHosts"
+)
+
+// WithCacheTTL sets how long list responses are cached. Zero disables caching,
+// concurrent identical lookups are still de-duplicated.
+func WithCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = newListCache(ttl)
+	}
+}
+
+// listCache caches list responses for a short time and collapses concurrent
+// fetches of the same list into a single request.
+type listCache struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	// generations is bumped on every invalidation so that fetches started
+	// before a write neither get stored nor shared with later callers.
+	generations map[string]uint64
+}
+
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+func newListCache(ttl time.Duration) *listCache {
+	return &listCache{
+		ttl:         ttl,
+		entries:     make(map[string]cacheEntry),
+		generations: make(map[string]uint64),
+	}
+}
+
+func (lc *listCache) get(ctx context.Context, key string, fetch func(context.Context) (any, error)) (any, error) {
+	for {
+		lc.mu.Lock()
+		entry, ok := lc.entries[key]
+		generation := lc.generations[key]
+		lc.mu.Unlock()
+
+		if ok && time.Now().Before(entry.expires) {
+			return entry.value, nil
+		}
+
+		ch := lc.group.DoChan(key+"@"+strconv.FormatUint(generation, 10), func() (any, error) {
+			value, err := fetch(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			lc.mu.Lock()
+			if lc.ttl > 0 && lc.generations[key] == generation {
+				lc.entries[key] = cacheEntry{value: value, expires: time.Now().Add(lc.ttl)}
+			}
+			lc.mu.Unlock()
+
+			return value, nil
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-ch:
+			// The shared fetch ran with the context of another caller. If
+			// that caller gave up, try again with ours.
+			if res.Err != nil && res.Shared && ctx.Err() == nil &&
+				(errors.Is(res.Err, context.Canceled) || errors.Is(res.Err, context.DeadlineExceeded)) {
+				continue
+			}
+			return res.Val, res.Err
+		}
+	}
+}
+
+func (lc *listCache) invalidate(keys ...string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	for _, key := range keys {
+		delete(lc.entries, key)
+		lc.generations[key]++
+	}
+}
+
+// cachedList returns a copy of the cached list for key, fetching it when
+// needed. Without a cache it simply calls fetch.
+func cachedList[T any](ctx context.Context, c *Client, key string, fetch func(context.Context) ([]T, error)) ([]T, error) {
+	if c.cache == nil {
+		return fetch(ctx)
+	}
+
+	value, err := c.cache.get(ctx, key, func(ctx context.Context) (any, error) {
+		return fetch(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(value.([]T)), nil
+}
+
+func (c *Client) invalidateCache(keys ...string) {
+	if c.cache != nil {
+		c.cache.invalidate(keys...)
+	}
+}
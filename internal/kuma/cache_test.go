@@ -0,0 +1,91 @@
+package kuma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTagListCache(t *testing.T) {
+	var lists atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tags":
+			lists.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"tags": [{"id": 1, "name": "env", "color": "#fff"}, {"id": 2, "name": "team", "color": "#000"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/tags":
+			_, _ = w.Write([]byte(`{"id": 3, "name": "new", "color": "#f00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"env", "team", "env", "team", "env"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetTag(context.Background(), name); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := client.GetTags(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := lists.Load(); got != 1 {
+		t.Fatalf("expected the tag list to be fetched once, got %d", got)
+	}
+
+	if _, err := client.CreateTag(context.Background(), Tag{Name: "new", Color: "#f00"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetTags(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := lists.Load(); got != 2 {
+		t.Fatalf("expected the tag list to be fetched again after a write, got %d", got)
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	var lists atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lists.Add(1)
+		_, _ = w.Write([]byte(`{"notifications": []}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil, WithCacheTTL(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetNotifications(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := lists.Load(); got != 3 {
+		t.Fatalf("expected every call to reach the server, got %d", got)
+	}
+}
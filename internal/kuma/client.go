@@ -1,6 +1,8 @@
 package kuma
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -8,10 +10,20 @@ import (
 	"time"
 )
 
+const (
+	DefaultRetryMax     = 4
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
 type requestOption func(*requestOptions)
 
 type requestOptions struct {
 	ContentType string
+	// NoReauth disables signing in again when the request is rejected with 401.
+	NoReauth bool
+	// Idempotent marks a POST as safe to repeat, like the other methods.
+	Idempotent bool
 }
 
 func withContentType(contentType string) requestOption {
@@ -20,26 +32,102 @@ func withContentType(contentType string) requestOption {
 	}
 }
 
-func NewClient(host, username, password *string) (*Client, error) {
+func withoutReauth() requestOption {
+	return func(o *requestOptions) {
+		o.NoReauth = true
+	}
+}
+
+func withIdempotent() requestOption {
+	return func(o *requestOptions) {
+		o.Idempotent = true
+	}
+}
+
+// ClientOption customizes a Client created by NewClient.
+type ClientOption func(*Client)
+
+// WithRetry sets how many times a failed request is retried and the bounds
+// of the exponential backoff between attempts.
+func WithRetry(retryMax int, waitMin, waitMax time.Duration) ClientOption {
+	return func(c *Client) {
+		c.RetryMax = retryMax
+		c.RetryWaitMin = waitMin
+		c.RetryWaitMax = waitMax
+	}
+}
+
+// WithToken authenticates with a pre-issued bearer token. It is used as is
+// when NewClient is given no username and password.
+func WithToken(token string) ClientOption {
+	return func(c *Client) {
+		c.Token = token
+	}
+}
+
+// WithTOTP configures the two-factor authentication sent on sign-in. A
+// secret generates a fresh code every time, a token is sent verbatim.
+func WithTOTP(secret, token string) ClientOption {
+	return func(c *Client) {
+		c.Auth.TOTPSecret = secret
+		c.Auth.TOTPToken = token
+	}
+}
+
+func NewClient(ctx context.Context, host, username, password *string, opts ...ClientOption) (*Client, error) {
 	clearHost := strings.TrimRight(*host, "/")
 
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 300 * time.Second},
-		HostURL:    clearHost,
-		Retry:      10,
-		Interval:   10 * time.Second,
+		HostURL:      clearHost,
+		RetryMax:     DefaultRetryMax,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
+		cache:        newListCache(DefaultCacheTTL),
 	}
 
-	if username == nil || password == nil {
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	if c.transport == nil {
+		c.transport = &TransportConfig{}
+	}
+
+	httpClient, err := c.transport.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	c.HTTPClient = httpClient
+
+	if username != nil && password != nil {
+		c.Auth.Username = *username
+		c.Auth.Password = *password
+	}
+
+	switch c.backend {
+	case "", BackendREST:
+	case BackendSocketIO:
+		dialer, err := c.transport.websocketDialer()
+		if err != nil {
+			return nil, err
+		}
+		c.socket = &socketBackend{c: &c, dialer: dialer}
+
+		// Sign in right away so that bad credentials fail early, like with REST.
+		if _, err := c.socket.current(ctx); err != nil {
+			return nil, err
+		}
+
 		return &c, nil
+	default:
+		return nil, fmt.Errorf("unsupported backend %q", c.backend)
 	}
 
-	c.Auth = AuthStruct{
-		Username: *username,
-		Password: *password,
+	if username == nil || password == nil {
+		return &c, nil
 	}
 
-	ar, err := c.SignIn()
+	ar, err := c.SignIn(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,10 +137,11 @@ func NewClient(host, username, password *string) (*Client, error) {
 	return &c, nil
 }
 
-func (c *Client) doRequest(method string, uri string, rb io.Reader, opts ...requestOption) ([]byte, *int, error) {
-	var error_messgae string
-	token := c.Token
+func (c *Client) doRequest(ctx context.Context, method string, uri string, rb io.Reader, opts ...requestOption) ([]byte, *int, error) {
+	token := c.token()
+	reauthenticated := false
 	clearUri := strings.TrimLeft(uri, "/")
+	ctx = newLogContext(ctx, token)
 
 	// Default options
 	options := requestOptions{
@@ -63,29 +152,93 @@ func (c *Client) doRequest(method string, uri string, rb io.Reader, opts ...requ
 		opt(&options)
 	}
 
-	req, err := http.NewRequest(method, fmt.Sprintf("%s/%s", c.HostURL, clearUri), rb)
-	if err != nil {
-		return nil, nil, err
+	// A POST that may have reached the server is not repeated, it could
+	// create the monitor, tag or docker host twice.
+	idempotent := method != http.MethodPost || options.Idempotent
+
+	// Buffer the request body so it can be replayed on retry.
+	var reqBody []byte
+	if rb != nil {
+		var err error
+		if reqBody, err = io.ReadAll(rb); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	req.Header.Add("Content-Type", options.ContentType)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
-
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		error_messgae = fmt.Sprintf("method %s API %s: %v", method, clearUri, err)
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", c.HostURL, clearUri), bytes.NewReader(reqBody))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req.Header.Add("Content-Type", options.ContentType)
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		release, err := c.acquire(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		logRequest(ctx, method, "/"+clearUri, attempt, options.ContentType, reqBody)
+		start := time.Now()
+
+		res, err := c.HTTPClient.Do(req)
+		if err != nil {
+			release()
+			logTransportError(ctx, method, "/"+clearUri, time.Since(start), err)
+			if ctx.Err() == nil && attempt < c.RetryMax && (idempotent || notSent(err)) {
+				if err := sleep(ctx, c.backoff(attempt, nil)); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			return nil, nil, fmt.Errorf("method %s API %s: %w", method, clearUri, err)
+		}
+
+		body, readErr := io.ReadAll(res.Body)
+		res.Body.Close()
+		release()
+		if readErr != nil {
+			return nil, &res.StatusCode, readErr
+		}
+
+		logResponse(ctx, method, "/"+clearUri, res.StatusCode, time.Since(start), res.Header.Get("Content-Type"), body)
+
+		if res.StatusCode == http.StatusUnauthorized && !options.NoReauth && !reauthenticated && c.canReauth() {
+			if token, err = c.refreshToken(ctx, token); err != nil {
+				return nil, &res.StatusCode, err
+			}
+			ctx = maskToken(ctx, token)
+			reauthenticated = true
+			// Replaying with the new token does not use up a retry.
+			attempt--
+			continue
+		}
+
+		if shouldRetry(res.StatusCode, idempotent) && attempt < c.RetryMax {
+			if err := sleep(ctx, c.backoff(attempt, res)); err != nil {
+				return nil, &res.StatusCode, err
+			}
+			continue
+		}
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return nil, &res.StatusCode, newAPIError(method, "/"+clearUri, res.StatusCode, body)
+		}
+
+		return body, &res.StatusCode, nil
 	}
+}
 
-	defer res.Body.Close()
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
 
-	body, readErr := io.ReadAll(res.Body)
-	if readErr != nil {
-		return nil, &res.StatusCode, readErr
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, &res.StatusCode, fmt.Errorf("status: %s, message: %s, body: %s", res.Status, error_messgae, body)
-	}
-
-	return body, &res.StatusCode, nil
 }
@@ -0,0 +1,63 @@
+package kuma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDoRequestConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	host := server.URL
+	server.Close()
+
+	username, password := "admin", "admin"
+
+	_, err := NewClient(context.Background(), &host, &username, &password, WithRetry(1, time.Millisecond, time.Millisecond))
+	if err == nil {
+		t.Fatal("expected an error when the server is unreachable")
+	}
+
+	client, err := NewClient(context.Background(), &host, nil, nil, WithRetry(0, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetMonitors(context.Background()); err == nil {
+		t.Fatal("expected an error when the server is unreachable")
+	}
+
+	if err := client.CreateMonitorTag(context.Background(), 1, MonitorTag{Name: "tag"}); err == nil {
+		t.Fatal("expected an error when the server is unreachable")
+	}
+}
+
+func TestDoRequestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"detail": "Monitor not found"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetMonitor(context.Background(), 42)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.Method != "GET" || apiErr.Path != "/monitors/42" || apiErr.Detail != "Monitor not found" {
+		t.Errorf("unexpected API error: %+v", apiErr)
+	}
+}
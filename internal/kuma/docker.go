@@ -0,0 +1,109 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetDockerHosts returns all docker hosts. The list is cached for the lifetime set by WithCacheTTL.
+func (c *Client) GetDockerHosts(ctx context.Context) ([]DockerHost, error) {
+	return cachedList(ctx, c, cacheKeyDockerHosts, c.fetchDockerHosts)
+}
+
+func (c *Client) fetchDockerHosts(ctx context.Context) ([]DockerHost, error) {
+	if c.socket != nil {
+		return c.socket.getDockerHosts(ctx)
+	}
+
+	body, _, err := c.doRequest(ctx, "GET", "/docker_hosts", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts struct {
+		DockerHosts []DockerHost `json:"docker_hosts"`
+	}
+
+	if err := json.Unmarshal(body, &hosts); err != nil {
+		return nil, err
+	}
+
+	return hosts.DockerHosts, nil
+}
+
+// GetDockerHost looks up a docker host by ID. It returns an error matching
+// ErrNotFound when no such host exists.
+func (c *Client) GetDockerHost(ctx context.Context, id int64) (*DockerHost, error) {
+	hosts, err := c.GetDockerHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range hosts {
+		if host.ID == id {
+			return &host, nil
+		}
+	}
+
+	return nil, fmt.Errorf("docker host %d: %w", id, ErrNotFound)
+}
+
+func (c *Client) CreateDockerHost(ctx context.Context, host DockerHost) (*DockerHost, error) {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyDockerHosts)
+		return c.socket.saveDockerHost(ctx, nil, host)
+	}
+
+	rb, err := json.Marshal(host)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _, err := c.doRequest(ctx, "POST", "/docker_hosts", strings.NewReader(string(rb)))
+	c.invalidateCache(cacheKeyDockerHosts)
+	if err != nil {
+		return nil, err
+	}
+
+	var newHost DockerHost
+
+	if err := json.Unmarshal(body, &newHost); err != nil {
+		return nil, err
+	}
+
+	return &newHost, nil
+}
+
+func (c *Client) UpdateDockerHost(ctx context.Context, id int64, host DockerHost) error {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyDockerHosts)
+		_, err := c.socket.saveDockerHost(ctx, &id, host)
+		return err
+	}
+
+	rb, err := json.Marshal(host)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.doRequest(ctx, "PATCH", "/docker_hosts/"+strconv.FormatInt(id, 10), strings.NewReader(string(rb)))
+	c.invalidateCache(cacheKeyDockerHosts)
+
+	return err
+}
+
+// DeleteDockerHost deletes a docker host. Kuma detaches the monitors using it.
+func (c *Client) DeleteDockerHost(ctx context.Context, id int64) error {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyDockerHosts, cacheKeyMonitors)
+		return c.socket.deleteDockerHost(ctx, id)
+	}
+
+	_, _, err := c.doRequest(ctx, "DELETE", "/docker_hosts/"+strconv.FormatInt(id, 10), nil)
+	c.invalidateCache(cacheKeyDockerHosts, cacheKeyMonitors)
+
+	return err
+}
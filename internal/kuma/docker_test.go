@@ -0,0 +1,70 @@
+package kuma_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+)
+
+func TestDockerHostLifecycle(t *testing.T) {
+	for _, backend := range []kuma.Backend{kuma.BackendREST, kuma.BackendSocketIO} {
+		t.Run(string(backend), func(t *testing.T) {
+			ctx := context.Background()
+			server := kumatest.NewServer(t)
+			client := server.NewClient(t, kuma.WithBackend(backend))
+
+			host, err := client.CreateDockerHost(ctx, kuma.DockerHost{
+				Name:         "local",
+				DockerDaemon: "/var/run/docker.sock",
+				DockerType:   "socket",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if host.ID == 0 {
+				t.Fatal("expected the created host to have an ID")
+			}
+
+			update := kuma.DockerHost{Name: "remote", DockerDaemon: "tcp://docker:2375", DockerType: "tcp"}
+			if err := client.UpdateDockerHost(ctx, host.ID, update); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := client.GetDockerHost(ctx, host.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			update.ID = host.ID
+			if *got != update {
+				t.Fatalf("unexpected docker host: %+v", got)
+			}
+
+			monitorID := server.AddMonitor(kuma.Monitor{Name: "app", Type: "docker", DockerContainer: "app", DockerHost: host.ID})
+
+			if err := client.DeleteDockerHost(ctx, host.ID); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := client.GetDockerHost(ctx, host.ID); !errors.Is(err, kuma.ErrNotFound) {
+				t.Fatalf("expected ErrNotFound, got %v", err)
+			}
+
+			if monitor, _ := server.Monitor(monitorID); monitor.DockerHost != 0 {
+				t.Fatalf("expected the monitor to be detached, got docker host %d", monitor.DockerHost)
+			}
+		})
+	}
+}
+
+func TestCreateDockerHostInvalid(t *testing.T) {
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	_, err := client.CreateDockerHost(context.Background(), kuma.DockerHost{Name: "local", DockerDaemon: "/var/run/docker.sock", DockerType: "ssh"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown docker type")
+	}
+}
@@ -0,0 +1,65 @@
+package kuma
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNotFound is matched by errors.Is for any API response with status 404.
+var ErrNotFound = errors.New("not found")
+
+// ErrTOTPRequired is returned by SignIn when the account has two-factor
+// authentication enabled and no TOTP secret or code was configured.
+var ErrTOTPRequired = errors.New("two-factor authentication code required")
+
+// ErrNoPrimaryBaseURL is returned by PushURL when the address of Uptime Kuma
+// itself is unknown: the server has no primary base URL and the client talks
+// to the REST wrapper rather than to Kuma.
+var ErrNoPrimaryBaseURL = errors.New("the server has no primary base URL")
+
+// APIError is returned by the client when the Kuma API answers with a non-2xx status.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	// Detail is the decoded "detail" field of the error body, or the raw body
+	// when it could not be decoded.
+	Detail string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("method %s API %s: status %d %s: %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode), e.Detail)
+}
+
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+func newAPIError(method, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Detail:     string(body),
+	}
+
+	var errBody struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+
+	if err := json.Unmarshal(body, &errBody); err != nil || len(errBody.Detail) == 0 {
+		return apiErr
+	}
+
+	// FastAPI returns either a plain message or a list of validation errors.
+	var detail string
+	if err := json.Unmarshal(errBody.Detail, &detail); err == nil {
+		apiErr.Detail = detail
+	} else {
+		apiErr.Detail = string(errBody.Detail)
+	}
+
+	return apiErr
+}
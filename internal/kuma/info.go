@@ -0,0 +1,117 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ServerInfo describes the Uptime Kuma instance behind the API.
+type ServerInfo struct {
+	Version              string `json:"version"`
+	LatestVersion        string `json:"latestVersion"`
+	PrimaryBaseURL       string `json:"primaryBaseURL"`
+	ServerTimezone       string `json:"serverTimezone"`
+	ServerTimezoneOffset string `json:"serverTimezoneOffset"`
+}
+
+// AtLeast reports whether the server runs version or later. Pre-releases
+// count as the release they lead to, so 2.0.0-beta.1 is at least 2.0.0. An
+// unknown server version is assumed to be recent enough.
+func (i ServerInfo) AtLeast(version string) bool {
+	have, ok := parseVersion(i.Version)
+	if !ok {
+		return true
+	}
+
+	want, ok := parseVersion(version)
+	if !ok {
+		return true
+	}
+
+	for n := range have {
+		if have[n] != want[n] {
+			return have[n] > want[n]
+		}
+	}
+
+	return true
+}
+
+// parseVersion splits a version such as 1.23.16 or 2.0.0-beta.2 into its
+// major, minor and patch numbers.
+func parseVersion(version string) ([3]int, bool) {
+	var parts [3]int
+
+	version = strings.TrimPrefix(version, "v")
+	version, _, _ = strings.Cut(version, "-")
+
+	fields := strings.Split(version, ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return parts, false
+	}
+
+	for n, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return parts, false
+		}
+		parts[n] = value
+	}
+
+	return parts, true
+}
+
+// GetServerInfo returns the version and settings of the Uptime Kuma server.
+// It is fetched once and reused for the lifetime of the client, and so is a
+// failure to fetch it, so that every resource does not ask again.
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	c.infoMu.Lock()
+	defer c.infoMu.Unlock()
+
+	if c.info != nil {
+		info := *c.info
+		return &info, nil
+	}
+
+	if c.infoErr != nil {
+		return nil, c.infoErr
+	}
+
+	var info *ServerInfo
+	var err error
+
+	if c.socket != nil {
+		info, err = c.socket.getServerInfo(ctx)
+	} else {
+		info, err = c.fetchServerInfo(ctx)
+	}
+	if err != nil {
+		// A canceled request says nothing about the server.
+		if ctx.Err() == nil {
+			c.infoErr = err
+		}
+		return nil, err
+	}
+
+	c.info = info
+	out := *info
+
+	return &out, nil
+}
+
+func (c *Client) fetchServerInfo(ctx context.Context) (*ServerInfo, error) {
+	body, _, err := c.doRequest(ctx, "GET", "/info", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var info ServerInfo
+
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
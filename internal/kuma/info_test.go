@@ -0,0 +1,85 @@
+package kuma_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+)
+
+func TestServerInfoAtLeast(t *testing.T) {
+	tests := []struct {
+		version string
+		min     string
+		want    bool
+	}{
+		{"1.23.16", "1.23.0", true},
+		{"1.23.16", "1.23.16", true},
+		{"1.21.3", "1.22.0", false},
+		{"2.0.0-beta.2", "2.0.0", true},
+		{"2.0.0", "1.23.0", true},
+		{"1.23", "1.23.1", false},
+		{"v2.1.0", "2.0.0", true},
+		{"", "2.0.0", true},
+		{"nightly", "2.0.0", true},
+	}
+
+	for _, tt := range tests {
+		if got := (kuma.ServerInfo{Version: tt.version}).AtLeast(tt.min); got != tt.want {
+			t.Errorf("AtLeast(%q) on %q = %v, want %v", tt.min, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestGetServerInfo(t *testing.T) {
+	for _, backend := range []kuma.Backend{kuma.BackendREST, kuma.BackendSocketIO} {
+		t.Run(string(backend), func(t *testing.T) {
+			ctx := context.Background()
+			server := kumatest.NewServer(t)
+			server.Version = "2.0.0-beta.2"
+			client := server.NewClient(t, kuma.WithBackend(backend))
+
+			info, err := client.GetServerInfo(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Version != "2.0.0-beta.2" || info.ServerTimezone != "UTC" {
+				t.Fatalf("unexpected server info: %+v", info)
+			}
+
+			// The info is fetched once per client.
+			server.Version = "2.0.0"
+			if info, err := client.GetServerInfo(ctx); err != nil || info.Version != "2.0.0-beta.2" {
+				t.Fatalf("expected the cached server info, got %+v, %v", info, err)
+			}
+		})
+	}
+}
+
+func TestGetServerInfoCachesFailure(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := kuma.NewClient(context.Background(), &server.URL, nil, nil, kuma.WithRetry(0, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetServerInfo(context.Background()); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
@@ -0,0 +1,379 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: terraform-provider-kuma/internal/kuma (interfaces: API)
+//
+// Generated by this command:
+//
+//	mockgen -destination=kumamock/api.go -package=kumamock . API
+//
+
+// Package kumamock is a generated GoMock package.
+package kumamock
+
+import (
+	context "context"
+	reflect "reflect"
+	kuma "terraform-provider-kuma/internal/kuma"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateDockerHost mocks base method.
+func (m *MockAPI) CreateDockerHost(ctx context.Context, host kuma.DockerHost) (*kuma.DockerHost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDockerHost", ctx, host)
+	ret0, _ := ret[0].(*kuma.DockerHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDockerHost indicates an expected call of CreateDockerHost.
+func (mr *MockAPIMockRecorder) CreateDockerHost(ctx, host any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDockerHost", reflect.TypeOf((*MockAPI)(nil).CreateDockerHost), ctx, host)
+}
+
+// CreateMonitor mocks base method.
+func (m *MockAPI) CreateMonitor(ctx context.Context, monitor kuma.Monitor) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMonitor", ctx, monitor)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMonitor indicates an expected call of CreateMonitor.
+func (mr *MockAPIMockRecorder) CreateMonitor(ctx, monitor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMonitor", reflect.TypeOf((*MockAPI)(nil).CreateMonitor), ctx, monitor)
+}
+
+// CreateMonitorTag mocks base method.
+func (m *MockAPI) CreateMonitorTag(ctx context.Context, monitorID int64, tagSet kuma.MonitorTag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMonitorTag", ctx, monitorID, tagSet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMonitorTag indicates an expected call of CreateMonitorTag.
+func (mr *MockAPIMockRecorder) CreateMonitorTag(ctx, monitorID, tagSet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMonitorTag", reflect.TypeOf((*MockAPI)(nil).CreateMonitorTag), ctx, monitorID, tagSet)
+}
+
+// CreateTag mocks base method.
+func (m *MockAPI) CreateTag(ctx context.Context, tag kuma.Tag) (*kuma.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, tag)
+	ret0, _ := ret[0].(*kuma.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockAPIMockRecorder) CreateTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockAPI)(nil).CreateTag), ctx, tag)
+}
+
+// DeleteDockerHost mocks base method.
+func (m *MockAPI) DeleteDockerHost(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDockerHost", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDockerHost indicates an expected call of DeleteDockerHost.
+func (mr *MockAPIMockRecorder) DeleteDockerHost(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDockerHost", reflect.TypeOf((*MockAPI)(nil).DeleteDockerHost), ctx, id)
+}
+
+// DeleteMonitor mocks base method.
+func (m *MockAPI) DeleteMonitor(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMonitor", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMonitor indicates an expected call of DeleteMonitor.
+func (mr *MockAPIMockRecorder) DeleteMonitor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMonitor", reflect.TypeOf((*MockAPI)(nil).DeleteMonitor), ctx, id)
+}
+
+// DeleteMonitorTag mocks base method.
+func (m *MockAPI) DeleteMonitorTag(ctx context.Context, monitorID int64, tagSet kuma.MonitorTag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMonitorTag", ctx, monitorID, tagSet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMonitorTag indicates an expected call of DeleteMonitorTag.
+func (mr *MockAPIMockRecorder) DeleteMonitorTag(ctx, monitorID, tagSet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMonitorTag", reflect.TypeOf((*MockAPI)(nil).DeleteMonitorTag), ctx, monitorID, tagSet)
+}
+
+// DeleteTag mocks base method.
+func (m *MockAPI) DeleteTag(ctx context.Context, tagId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockAPIMockRecorder) DeleteTag(ctx, tagId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockAPI)(nil).DeleteTag), ctx, tagId)
+}
+
+// GetDefaultNotifications mocks base method.
+func (m *MockAPI) GetDefaultNotifications(ctx context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultNotifications", ctx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultNotifications indicates an expected call of GetDefaultNotifications.
+func (mr *MockAPIMockRecorder) GetDefaultNotifications(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultNotifications", reflect.TypeOf((*MockAPI)(nil).GetDefaultNotifications), ctx)
+}
+
+// GetDockerHost mocks base method.
+func (m *MockAPI) GetDockerHost(ctx context.Context, id int64) (*kuma.DockerHost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDockerHost", ctx, id)
+	ret0, _ := ret[0].(*kuma.DockerHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDockerHost indicates an expected call of GetDockerHost.
+func (mr *MockAPIMockRecorder) GetDockerHost(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerHost", reflect.TypeOf((*MockAPI)(nil).GetDockerHost), ctx, id)
+}
+
+// GetDockerHosts mocks base method.
+func (m *MockAPI) GetDockerHosts(ctx context.Context) ([]kuma.DockerHost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDockerHosts", ctx)
+	ret0, _ := ret[0].([]kuma.DockerHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDockerHosts indicates an expected call of GetDockerHosts.
+func (mr *MockAPIMockRecorder) GetDockerHosts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDockerHosts", reflect.TypeOf((*MockAPI)(nil).GetDockerHosts), ctx)
+}
+
+// GetMonitor mocks base method.
+func (m *MockAPI) GetMonitor(ctx context.Context, id int64) (*kuma.Monitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitor", ctx, id)
+	ret0, _ := ret[0].(*kuma.Monitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitor indicates an expected call of GetMonitor.
+func (mr *MockAPIMockRecorder) GetMonitor(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitor", reflect.TypeOf((*MockAPI)(nil).GetMonitor), ctx, id)
+}
+
+// GetMonitors mocks base method.
+func (m *MockAPI) GetMonitors(ctx context.Context) ([]kuma.Monitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitors", ctx)
+	ret0, _ := ret[0].([]kuma.Monitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitors indicates an expected call of GetMonitors.
+func (mr *MockAPIMockRecorder) GetMonitors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitors", reflect.TypeOf((*MockAPI)(nil).GetMonitors), ctx)
+}
+
+// GetNotification mocks base method.
+func (m *MockAPI) GetNotification(ctx context.Context, id int64) (*kuma.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, id)
+	ret0, _ := ret[0].(*kuma.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockAPIMockRecorder) GetNotification(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockAPI)(nil).GetNotification), ctx, id)
+}
+
+// GetNotifications mocks base method.
+func (m *MockAPI) GetNotifications(ctx context.Context) ([]kuma.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx)
+	ret0, _ := ret[0].([]kuma.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockAPIMockRecorder) GetNotifications(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockAPI)(nil).GetNotifications), ctx)
+}
+
+// GetServerInfo mocks base method.
+func (m *MockAPI) GetServerInfo(ctx context.Context) (*kuma.ServerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerInfo", ctx)
+	ret0, _ := ret[0].(*kuma.ServerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerInfo indicates an expected call of GetServerInfo.
+func (mr *MockAPIMockRecorder) GetServerInfo(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerInfo", reflect.TypeOf((*MockAPI)(nil).GetServerInfo), ctx)
+}
+
+// GetTag mocks base method.
+func (m *MockAPI) GetTag(ctx context.Context, tagName string) (*kuma.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag", ctx, tagName)
+	ret0, _ := ret[0].(*kuma.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockAPIMockRecorder) GetTag(ctx, tagName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockAPI)(nil).GetTag), ctx, tagName)
+}
+
+// GetTags mocks base method.
+func (m *MockAPI) GetTags(ctx context.Context) ([]kuma.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret0, _ := ret[0].([]kuma.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockAPIMockRecorder) GetTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockAPI)(nil).GetTags), ctx)
+}
+
+// PushURL mocks base method.
+func (m *MockAPI) PushURL(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushURL", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PushURL indicates an expected call of PushURL.
+func (mr *MockAPIMockRecorder) PushURL(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushURL", reflect.TypeOf((*MockAPI)(nil).PushURL), ctx, token)
+}
+
+// SignIn mocks base method.
+func (m *MockAPI) SignIn(ctx context.Context) (*kuma.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignIn", ctx)
+	ret0, _ := ret[0].(*kuma.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockAPIMockRecorder) SignIn(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockAPI)(nil).SignIn), ctx)
+}
+
+// UpdateDockerHost mocks base method.
+func (m *MockAPI) UpdateDockerHost(ctx context.Context, id int64, host kuma.DockerHost) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDockerHost", ctx, id, host)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDockerHost indicates an expected call of UpdateDockerHost.
+func (mr *MockAPIMockRecorder) UpdateDockerHost(ctx, id, host any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDockerHost", reflect.TypeOf((*MockAPI)(nil).UpdateDockerHost), ctx, id, host)
+}
+
+// UpdateMonitor mocks base method.
+func (m *MockAPI) UpdateMonitor(ctx context.Context, monitorID int64, monitor kuma.Monitor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMonitor", ctx, monitorID, monitor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMonitor indicates an expected call of UpdateMonitor.
+func (mr *MockAPIMockRecorder) UpdateMonitor(ctx, monitorID, monitor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMonitor", reflect.TypeOf((*MockAPI)(nil).UpdateMonitor), ctx, monitorID, monitor)
+}
+
+// UpdateTag mocks base method.
+func (m *MockAPI) UpdateTag(ctx context.Context, tagId int64, tagInfo kuma.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tagId, tagInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockAPIMockRecorder) UpdateTag(ctx, tagId, tagInfo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockAPI)(nil).UpdateTag), ctx, tagId, tagInfo)
+}
@@ -0,0 +1,152 @@
+package kumatest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"terraform-provider-kuma/internal/kuma"
+)
+
+// AddDockerHost stores a docker host directly, bypassing the API.
+func (s *Server) AddDockerHost(host kuma.DockerHost) kuma.DockerHost {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.saveDockerHost(0, host)
+}
+
+// DockerHosts returns copies of all stored docker hosts ordered by ID.
+func (s *Server) DockerHosts() []kuma.DockerHost {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.listDockerHosts()
+}
+
+// DeleteDockerHost removes a docker host, simulating a deletion made outside
+// of the client.
+func (s *Server) DeleteDockerHost(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteDockerHost(id)
+}
+
+// saveDockerHost adds the host, or replaces the one with the given ID.
+// s.mu must be held.
+func (s *Server) saveDockerHost(id int64, host kuma.DockerHost) kuma.DockerHost {
+	if id == 0 {
+		s.nextDockerHostID++
+		id = s.nextDockerHostID
+	}
+
+	host.ID = id
+	s.dockerHosts[id] = &host
+
+	return host
+}
+
+// deleteDockerHost removes the host and, like Kuma, detaches the monitors
+// using it. s.mu must be held.
+func (s *Server) deleteDockerHost(id int64) {
+	delete(s.dockerHosts, id)
+	for _, monitor := range s.monitors {
+		if monitor.DockerHost == id {
+			monitor.DockerHost = 0
+		}
+	}
+}
+
+// listDockerHosts returns copies of all docker hosts. s.mu must be held.
+func (s *Server) listDockerHosts() []kuma.DockerHost {
+	hosts := make([]kuma.DockerHost, 0, len(s.dockerHosts))
+	for _, id := range sortedKeys(s.dockerHosts) {
+		hosts = append(hosts, *s.dockerHosts[id])
+	}
+
+	return hosts
+}
+
+func (s *Server) handleListDockerHosts(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"docker_hosts": s.listDockerHosts()})
+}
+
+func (s *Server) handleCreateDockerHost(w http.ResponseWriter, r *http.Request) {
+	var host kuma.DockerHost
+	if !decodeBody(w, r, &host) {
+		return
+	}
+
+	if errs := validateDockerHost(host); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.saveDockerHost(0, host))
+}
+
+func (s *Server) handleUpdateDockerHost(w http.ResponseWriter, r *http.Request) {
+	host, ok := s.lookupDockerHost(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *host
+	if !decodeBody(w, r, &updated) {
+		return
+	}
+
+	if errs := validateDockerHost(updated); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.saveDockerHost(host.ID, updated))
+}
+
+func (s *Server) handleDeleteDockerHost(w http.ResponseWriter, r *http.Request) {
+	host, ok := s.lookupDockerHost(w, r)
+	if !ok {
+		return
+	}
+
+	s.deleteDockerHost(host.ID)
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Deleted Successfully."})
+}
+
+func (s *Server) lookupDockerHost(w http.ResponseWriter, r *http.Request) (*kuma.DockerHost, bool) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	host, ok := s.dockerHosts[id]
+	if !ok {
+		writeDetail(w, http.StatusNotFound, fmt.Sprintf("Docker host %d not found", id))
+		return nil, false
+	}
+
+	return host, true
+}
+
+func validateDockerHost(host kuma.DockerHost) []validationError {
+	var errs []validationError
+
+	if host.Name == "" {
+		errs = append(errs, missingField("name"))
+	}
+	if host.DockerDaemon == "" {
+		errs = append(errs, missingField("dockerDaemon"))
+	}
+	if !slices.Contains([]string{"socket", "tcp"}, host.DockerType) {
+		errs = append(errs, validationError{
+			Loc:  []string{"body", "dockerType"},
+			Msg:  "value is not a valid enumeration member; permitted: socket, tcp",
+			Type: "type_error.enum",
+		})
+	}
+
+	return errs
+}
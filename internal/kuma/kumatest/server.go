@@ -0,0 +1,761 @@
+// Package kumatest provides an in-memory fake of the Uptime Kuma REST wrapper
+// API and of the Socket.IO API of Uptime Kuma for tests of the kuma client
+// and the provider. Both APIs share the same state.
+package kumatest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+)
+
+const (
+	DefaultUsername = "admin"
+	DefaultPassword = "admin123"
+	DefaultVersion  = "1.23.16"
+)
+
+// monitorTypes are the monitor types accepted by Uptime Kuma 1.23.
+var monitorTypes = []string{
+	"http", "keyword", "json-query", "grpc-keyword", "group", "port", "ping", "dns",
+	"docker", "push", "steam", "gamedig", "mqtt", "kafka-producer", "sqlserver",
+	"postgres", "mysql", "mongodb", "radius", "redis", "real-browser", "tailscale-ping",
+}
+
+// Server is a fake Kuma API backed by in-memory state. It is safe for
+// concurrent use by the client under test and the test itself.
+type Server struct {
+	URL      string
+	Username string
+	Password string
+
+	// Version is the Uptime Kuma version the server reports. Set it before
+	// creating a client to emulate an older or newer release.
+	Version string
+
+	// PrimaryBaseURL is the primary base URL set in the server settings,
+	// the server URL unless changed. Clear it to emulate a server without one.
+	PrimaryBaseURL string
+
+	server *httptest.Server
+
+	mu            sync.Mutex
+	tokens        map[string]bool
+	monitors      map[int64]*kuma.Monitor
+	tags          map[int64]*kuma.Tag
+	notifications map[int64]*kuma.Notification
+	nextMonitorID int64
+	nextTagID     int64
+	nextNotifID   int64
+
+	dockerHosts      map[int64]*kuma.DockerHost
+	nextDockerHostID int64
+
+	sockets      map[*socketSession]bool
+	socketEvents []string
+}
+
+// NewServer starts a fake server that is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		Username:      DefaultUsername,
+		Password:      DefaultPassword,
+		Version:       DefaultVersion,
+		tokens:        make(map[string]bool),
+		monitors:      make(map[int64]*kuma.Monitor),
+		tags:          make(map[int64]*kuma.Tag),
+		notifications: make(map[int64]*kuma.Notification),
+		dockerHosts:   make(map[int64]*kuma.DockerHost),
+		sockets:       make(map[*socketSession]bool),
+	}
+
+	s.server = httptest.NewServer(s.routes())
+	s.URL = s.server.URL
+	s.PrimaryBaseURL = s.URL
+	t.Cleanup(s.server.Close)
+	// Clients keep their Socket.IO connection open, drop them first.
+	t.Cleanup(s.CloseSockets)
+
+	return s
+}
+
+// Close shuts the server down, making further requests fail to connect.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ExpireTokens invalidates every access token issued so far.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.tokens)
+}
+
+// AddTag stores a tag as if it was created in the UI and returns it with its ID.
+func (s *Server) AddTag(tag kuma.Tag) kuma.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextTagID++
+	tag.ID = s.nextTagID
+	s.tags[tag.ID] = &tag
+
+	return tag
+}
+
+// AddNotification stores a notification and returns it with its ID.
+func (s *Server) AddNotification(notification kuma.Notification) kuma.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextNotifID++
+	notification.ID = s.nextNotifID
+	notification.UserId = 1
+	s.notifications[notification.ID] = &notification
+
+	return notification
+}
+
+// AddMonitor stores a monitor as if it was created in the UI and returns its ID.
+func (s *Server) AddMonitor(monitor kuma.Monitor) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addMonitor(monitor)
+}
+
+// Monitor returns a copy of the stored monitor.
+func (s *Server) Monitor(id int64) (kuma.Monitor, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	monitor, ok := s.monitors[id]
+	if !ok {
+		return kuma.Monitor{}, false
+	}
+
+	return s.render(monitor), true
+}
+
+// Monitors returns copies of all stored monitors ordered by ID.
+func (s *Server) Monitors() []kuma.Monitor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.listMonitors()
+}
+
+// UpdateMonitor changes a stored monitor in place, simulating an edit made
+// outside of the client.
+func (s *Server) UpdateMonitor(id int64, update func(*kuma.Monitor)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if monitor, ok := s.monitors[id]; ok {
+		update(monitor)
+	}
+}
+
+// DeleteMonitor removes a monitor, simulating a deletion made outside of the client.
+func (s *Server) DeleteMonitor(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.monitors, id)
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /login/access-token/", s.handleLogin)
+
+	mux.HandleFunc("GET /monitors", s.authenticated(s.handleListMonitors))
+	mux.HandleFunc("POST /monitors", s.authenticated(s.handleCreateMonitor))
+	mux.HandleFunc("GET /monitors/{id}", s.authenticated(s.handleGetMonitor))
+	mux.HandleFunc("PATCH /monitors/{id}", s.authenticated(s.handleUpdateMonitor))
+	mux.HandleFunc("DELETE /monitors/{id}", s.authenticated(s.handleDeleteMonitor))
+	mux.HandleFunc("POST /monitors/{id}/tag", s.authenticated(s.handleAddMonitorTag))
+	mux.HandleFunc("DELETE /monitors/{id}/tag/", s.authenticated(s.handleDeleteMonitorTag))
+
+	mux.HandleFunc("GET /tags", s.authenticated(s.handleListTags))
+	mux.HandleFunc("POST /tags", s.authenticated(s.handleCreateTag))
+	mux.HandleFunc("PATCH /tags/{id}", s.authenticated(s.handleUpdateTag))
+	mux.HandleFunc("DELETE /tags/{id}", s.authenticated(s.handleDeleteTag))
+
+	mux.HandleFunc("GET /notifications", s.authenticated(s.handleListNotifications))
+	mux.HandleFunc("GET /notifications/{id}", s.authenticated(s.handleGetNotification))
+
+	mux.HandleFunc("GET /docker_hosts", s.authenticated(s.handleListDockerHosts))
+	mux.HandleFunc("POST /docker_hosts", s.authenticated(s.handleCreateDockerHost))
+	mux.HandleFunc("PATCH /docker_hosts/{id}", s.authenticated(s.handleUpdateDockerHost))
+	mux.HandleFunc("DELETE /docker_hosts/{id}", s.authenticated(s.handleDeleteDockerHost))
+
+	mux.HandleFunc("GET /info", s.authenticated(s.handleInfo))
+
+	mux.HandleFunc("GET /socket.io/", s.handleSocketIO)
+
+	return mux
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeDetail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if r.PostForm.Get("username") != s.Username || r.PostForm.Get("password") != s.Password {
+		writeDetail(w, http.StatusUnauthorized, "Incorrect username or password")
+		return
+	}
+
+	token := newToken()
+
+	s.mu.Lock()
+	s.tokens[token] = true
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"access_token": token,
+		"token_type":   "bearer",
+	})
+}
+
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+		s.mu.Lock()
+		valid := ok && s.tokens[token]
+		s.mu.Unlock()
+
+		if !valid {
+			writeDetail(w, http.StatusUnauthorized, "Could not validate credentials")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		next(w, r)
+	}
+}
+
+func (s *Server) handleInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.info(true))
+}
+
+// info returns the server info, with or without the version. s.mu must be
+// held.
+func (s *Server) info(withVersion bool) kuma.ServerInfo {
+	info := kuma.ServerInfo{
+		PrimaryBaseURL:       s.PrimaryBaseURL,
+		ServerTimezone:       "UTC",
+		ServerTimezoneOffset: "+00:00",
+	}
+	if withVersion {
+		info.Version = s.Version
+		info.LatestVersion = s.Version
+	}
+	return info
+}
+
+func (s *Server) handleListMonitors(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"monitors": s.listMonitors()})
+}
+
+func (s *Server) handleGetMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := s.lookupMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"monitor": s.render(monitor)})
+}
+
+func (s *Server) handleCreateMonitor(w http.ResponseWriter, r *http.Request) {
+	var monitor kuma.Monitor
+	if !decodeBody(w, r, &monitor) {
+		return
+	}
+
+	if errs := s.validateMonitor(monitor); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	id := s.addMonitor(monitor)
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Added Successfully.", "monitorId": id})
+}
+
+func (s *Server) handleUpdateMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := s.lookupMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	// Like the wrapper, apply the sent fields on top of the stored monitor.
+	updated := *monitor
+	if !decodeBody(w, r, &updated) {
+		return
+	}
+
+	if errs := s.validateMonitor(updated); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	updated.ID = monitor.ID
+	updated.Tags = monitor.Tags
+	*monitor = updated
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Saved Successfully.", "monitorID": monitor.ID})
+}
+
+func (s *Server) handleDeleteMonitor(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := s.lookupMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.monitors, monitor.ID)
+	for _, child := range s.monitors {
+		if child.Parent == monitor.ID {
+			child.Parent = 0
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Deleted Successfully."})
+}
+
+type monitorTagRequest struct {
+	TagID *int64 `json:"tag_id"`
+	Value string `json:"value"`
+}
+
+func (s *Server) handleAddMonitorTag(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := s.lookupMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	var req monitorTagRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if req.TagID == nil {
+		writeValidationErrors(w, []validationError{missingField("tag_id")})
+		return
+	}
+
+	tag, ok := s.tags[*req.TagID]
+	if !ok {
+		writeDetail(w, http.StatusNotFound, "Tag not found")
+		return
+	}
+
+	for _, existing := range monitor.Tags {
+		if existing.TagId == tag.ID && existing.Value == req.Value {
+			writeDetail(w, http.StatusBadRequest, "Tag already added to monitor")
+			return
+		}
+	}
+
+	monitor.Tags = append(monitor.Tags, kuma.MonitorTag{TagId: tag.ID, Value: req.Value})
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Added Successfully."})
+}
+
+func (s *Server) handleDeleteMonitorTag(w http.ResponseWriter, r *http.Request) {
+	monitor, ok := s.lookupMonitor(w, r)
+	if !ok {
+		return
+	}
+
+	var req monitorTagRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if req.TagID == nil {
+		writeValidationErrors(w, []validationError{missingField("tag_id")})
+		return
+	}
+
+	index := slices.IndexFunc(monitor.Tags, func(tag kuma.MonitorTag) bool {
+		return tag.TagId == *req.TagID && tag.Value == req.Value
+	})
+	if index < 0 {
+		writeDetail(w, http.StatusNotFound, "Monitor tag not found")
+		return
+	}
+
+	monitor.Tags = slices.Delete(monitor.Tags, index, index+1)
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Deleted Successfully."})
+}
+
+func (s *Server) handleListTags(w http.ResponseWriter, r *http.Request) {
+	tags := make([]kuma.Tag, 0, len(s.tags))
+	for _, id := range sortedKeys(s.tags) {
+		tags = append(tags, *s.tags[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"tags": tags})
+}
+
+func (s *Server) handleCreateTag(w http.ResponseWriter, r *http.Request) {
+	var tag kuma.Tag
+	if !decodeBody(w, r, &tag) {
+		return
+	}
+
+	if errs := validateTag(tag); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	for _, existing := range s.tags {
+		if existing.Name == tag.Name {
+			writeDetail(w, http.StatusBadRequest, "Tag already exists")
+			return
+		}
+	}
+
+	s.nextTagID++
+	tag.ID = s.nextTagID
+	s.tags[tag.ID] = &tag
+
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) handleUpdateTag(w http.ResponseWriter, r *http.Request) {
+	tag, ok := s.lookupTag(w, r)
+	if !ok {
+		return
+	}
+
+	updated := *tag
+	if !decodeBody(w, r, &updated) {
+		return
+	}
+
+	if errs := validateTag(updated); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	updated.ID = tag.ID
+	*tag = updated
+
+	writeJSON(w, http.StatusOK, tag)
+}
+
+func (s *Server) handleDeleteTag(w http.ResponseWriter, r *http.Request) {
+	tag, ok := s.lookupTag(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.tags, tag.ID)
+	for _, monitor := range s.monitors {
+		monitor.Tags = slices.DeleteFunc(monitor.Tags, func(t kuma.MonitorTag) bool {
+			return t.TagId == tag.ID
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"msg": "Deleted Successfully."})
+}
+
+func (s *Server) handleListNotifications(w http.ResponseWriter, r *http.Request) {
+	notifications := make([]kuma.Notification, 0, len(s.notifications))
+	for _, id := range sortedKeys(s.notifications) {
+		notifications = append(notifications, *s.notifications[id])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"notifications": notifications})
+}
+
+func (s *Server) handleGetNotification(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	notification, ok := s.notifications[id]
+	if !ok {
+		writeDetail(w, http.StatusNotFound, "Notification not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, notification)
+}
+
+// addMonitor stores a new monitor. s.mu must be held.
+func (s *Server) addMonitor(monitor kuma.Monitor) int64 {
+	s.nextMonitorID++
+	monitor.ID = s.nextMonitorID
+	monitor.Active = true
+	monitor.Tags = nil
+
+	// Uptime Kuma 2 fills the ping options from column defaults.
+	if (kuma.ServerInfo{Version: s.Version}).AtLeast("2.0.0") {
+		if monitor.PingCount == 0 {
+			monitor.PingCount = 1
+		}
+		if monitor.PingPerRequestTimeout == 0 {
+			monitor.PingPerRequestTimeout = 2
+		}
+		if monitor.Conditions == nil {
+			monitor.Conditions = &[]kuma.MonitorCondition{}
+		}
+	}
+
+	s.monitors[monitor.ID] = &monitor
+
+	return monitor.ID
+}
+
+// listMonitors returns rendered copies of all monitors. s.mu must be held.
+func (s *Server) listMonitors() []kuma.Monitor {
+	monitors := make([]kuma.Monitor, 0, len(s.monitors))
+	for _, id := range sortedKeys(s.monitors) {
+		monitors = append(monitors, s.render(s.monitors[id]))
+	}
+
+	return monitors
+}
+
+// render returns a copy of monitor with the fields Kuma computes on read
+// filled in. s.mu must be held.
+func (s *Server) render(monitor *kuma.Monitor) kuma.Monitor {
+	out := *monitor
+	out.PathName = s.pathName(monitor)
+	out.NotificationIDList = slices.Clone(monitor.NotificationIDList)
+	out.AcceptedStatusCodes = slices.Clone(monitor.AcceptedStatusCodes)
+	out.ChildrenIDs = nil
+	out.Tags = nil
+
+	for _, id := range sortedKeys(s.monitors) {
+		if s.monitors[id].Parent == monitor.ID {
+			out.ChildrenIDs = append(out.ChildrenIDs, id)
+		}
+	}
+
+	for _, tag := range monitor.Tags {
+		if t, ok := s.tags[tag.TagId]; ok {
+			out.Tags = append(out.Tags, kuma.MonitorTag{TagId: t.ID, Name: t.Name, Value: tag.Value})
+		}
+	}
+
+	return out
+}
+
+func (s *Server) pathName(monitor *kuma.Monitor) string {
+	if parent, ok := s.monitors[monitor.Parent]; ok && parent.ID != monitor.ID {
+		return s.pathName(parent) + " / " + monitor.Name
+	}
+	return monitor.Name
+}
+
+func (s *Server) validateMonitor(monitor kuma.Monitor) []validationError {
+	var errs []validationError
+
+	if monitor.Name == "" {
+		errs = append(errs, missingField("name"))
+	}
+
+	if !slices.Contains(monitorTypes, monitor.Type) {
+		errs = append(errs, validationError{
+			Loc:  []string{"body", "type"},
+			Msg:  fmt.Sprintf("value is not a valid enumeration member; permitted: %s", strings.Join(monitorTypes, ", ")),
+			Type: "type_error.enum",
+		})
+	}
+
+	switch monitor.Type {
+	case "http", "keyword", "json-query":
+		if monitor.Url == "" {
+			errs = append(errs, missingField("url"))
+		}
+	case "docker":
+		if monitor.DockerContainer == "" {
+			errs = append(errs, missingField("docker_container"))
+		}
+		if _, ok := s.dockerHosts[monitor.DockerHost]; !ok {
+			errs = append(errs, validationError{
+				Loc:  []string{"body", "docker_host"},
+				Msg:  "docker_host must be an existing docker host",
+				Type: "value_error",
+			})
+		}
+	case "push":
+		if monitor.PushToken == "" {
+			errs = append(errs, missingField("pushToken"))
+		}
+	case "grpc-keyword":
+		if monitor.GRPCURL == "" {
+			errs = append(errs, missingField("grpcUrl"))
+		}
+	case "postgres", "mysql", "sqlserver", "mongodb", "redis":
+		if monitor.DatabaseConnectionString == "" {
+			errs = append(errs, missingField("databaseConnectionString"))
+		}
+	case "port", "ping", "dns":
+		if monitor.Hostname == "" {
+			errs = append(errs, missingField("hostname"))
+		}
+		if monitor.Type == "port" && monitor.Port == 0 {
+			errs = append(errs, missingField("port"))
+		}
+	}
+
+	if monitor.Parent != 0 {
+		parent, ok := s.monitors[monitor.Parent]
+		if !ok || parent.Type != "group" {
+			errs = append(errs, validationError{
+				Loc:  []string{"body", "parent"},
+				Msg:  "parent must be an existing group monitor",
+				Type: "value_error",
+			})
+		}
+	}
+
+	for _, id := range monitor.NotificationIDList {
+		if _, ok := s.notifications[id]; !ok {
+			errs = append(errs, validationError{
+				Loc:  []string{"body", "notificationIDList"},
+				Msg:  fmt.Sprintf("notification %d does not exist", id),
+				Type: "value_error",
+			})
+		}
+	}
+
+	return errs
+}
+
+func validateTag(tag kuma.Tag) []validationError {
+	var errs []validationError
+
+	if tag.Name == "" {
+		errs = append(errs, missingField("name"))
+	}
+	if tag.Color == "" {
+		errs = append(errs, missingField("color"))
+	}
+
+	return errs
+}
+
+func (s *Server) lookupMonitor(w http.ResponseWriter, r *http.Request) (*kuma.Monitor, bool) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	monitor, ok := s.monitors[id]
+	if !ok {
+		writeDetail(w, http.StatusNotFound, fmt.Sprintf("Monitor %d not found", id))
+		return nil, false
+	}
+
+	return monitor, true
+}
+
+func (s *Server) lookupTag(w http.ResponseWriter, r *http.Request) (*kuma.Tag, bool) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	tag, ok := s.tags[id]
+	if !ok {
+		writeDetail(w, http.StatusNotFound, fmt.Sprintf("Tag %d not found", id))
+		return nil, false
+	}
+
+	return tag, true
+}
+
+// validationError mirrors the entries of a FastAPI 422 response.
+type validationError struct {
+	Loc  []string `json:"loc"`
+	Msg  string   `json:"msg"`
+	Type string   `json:"type"`
+}
+
+func missingField(name string) validationError {
+	return validationError{Loc: []string{"body", name}, Msg: "field required", Type: "value_error.missing"}
+}
+
+func writeValidationErrors(w http.ResponseWriter, errs []validationError) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{"detail": errs})
+}
+
+func writeDetail(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]string{"detail": detail})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, v)
+	}
+	if err != nil {
+		writeValidationErrors(w, []validationError{{Loc: []string{"body"}, Msg: err.Error(), Type: "value_error.jsondecode"}})
+		return false
+	}
+	return true
+}
+
+func pathID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeValidationErrors(w, []validationError{{Loc: []string{"path", "id"}, Msg: "value is not a valid integer", Type: "type_error.integer"}})
+		return 0, false
+	}
+	return id, true
+}
+
+func sortedKeys[V any](m map[int64]V) []int64 {
+	keys := make([]int64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// NewClient returns a client signed in to the server. Retries are disabled so
+// that failures surface immediately.
+func (s *Server) NewClient(t testing.TB, opts ...kuma.ClientOption) *kuma.Client {
+	t.Helper()
+
+	opts = append([]kuma.ClientOption{kuma.WithRetry(0, 0, 0)}, opts...)
+
+	client, err := kuma.NewClient(context.Background(), &s.URL, &s.Username, &s.Password, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
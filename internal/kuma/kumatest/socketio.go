@@ -0,0 +1,463 @@
+package kumatest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/gorilla/websocket"
+)
+
+// socketPingInterval is announced in the Engine.IO handshake, in milliseconds.
+const socketPingInterval = 25000
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// wireMonitor is a monitor as exchanged over Socket.IO, where the
+// notifications are a set keyed by ID.
+type wireMonitor struct {
+	kuma.Monitor
+	NotificationIDList map[string]bool `json:"notificationIDList"`
+}
+
+func toWire(monitor kuma.Monitor) wireMonitor {
+	wire := wireMonitor{Monitor: monitor, NotificationIDList: make(map[string]bool)}
+	for _, id := range monitor.NotificationIDList {
+		wire.NotificationIDList[strconv.FormatInt(id, 10)] = true
+	}
+	return wire
+}
+
+func (w wireMonitor) monitor() kuma.Monitor {
+	monitor := w.Monitor
+	monitor.NotificationIDList = nil
+	for key, enabled := range w.NotificationIDList {
+		if id, err := strconv.ParseInt(key, 10, 64); err == nil && enabled {
+			monitor.NotificationIDList = append(monitor.NotificationIDList, id)
+		}
+	}
+	slices.Sort(monitor.NotificationIDList)
+	return monitor
+}
+
+// socketSession is one Socket.IO client connected to the server.
+type socketSession struct {
+	server *Server
+	ws     *websocket.Conn
+
+	writeMu  sync.Mutex
+	loggedIn bool
+}
+
+// CloseSockets drops every Socket.IO connection, as a server restart would.
+func (s *Server) CloseSockets() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for session := range s.sockets {
+		session.ws.Close()
+	}
+}
+
+// SocketEvents returns the names of the Socket.IO events received so far, in order.
+func (s *Server) SocketEvents() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.socketEvents)
+}
+
+func (s *Server) handleSocketIO(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("EIO") != "4" || r.URL.Query().Get("transport") != "websocket" {
+		http.Error(w, "unsupported transport", http.StatusBadRequest)
+		return
+	}
+
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	session := &socketSession{server: s, ws: ws}
+
+	s.mu.Lock()
+	s.sockets[session] = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.sockets, session)
+		s.mu.Unlock()
+		ws.Close()
+	}()
+
+	session.serve()
+}
+
+func (c *socketSession) serve() {
+	sid := newToken()
+
+	open, _ := json.Marshal(map[string]any{
+		"sid":          sid,
+		"upgrades":     []string{},
+		"pingInterval": socketPingInterval,
+		"pingTimeout":  20000,
+		"maxPayload":   1000000,
+	})
+	if c.write("0"+string(open)) != nil {
+		return
+	}
+
+	for {
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		msg := string(data)
+		switch {
+		case msg == "3":
+			// Pong
+		case msg == "40":
+			// Ping right away so the client has to answer before sending events.
+			if c.write(`40{"sid":"`+sid+`"}`) != nil || c.write("2") != nil {
+				return
+			}
+			// Kuma tells every client its timezone, but only signed-in
+			// clients its version.
+			c.server.mu.Lock()
+			info := c.server.info(false)
+			c.server.mu.Unlock()
+			if c.emit("info", info) != nil {
+				return
+			}
+		case strings.HasPrefix(msg, "42"):
+			if err := c.handleEvent(msg[2:]); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *socketSession) handleEvent(packet string) error {
+	digits := 0
+	for digits < len(packet) && packet[digits] >= '0' && packet[digits] <= '9' {
+		digits++
+	}
+	ackID, payload := packet[:digits], packet[digits:]
+
+	var args []json.RawMessage
+	if err := json.Unmarshal([]byte(payload), &args); err != nil || len(args) == 0 {
+		return fmt.Errorf("malformed event %q", packet)
+	}
+
+	var event string
+	if err := json.Unmarshal(args[0], &event); err != nil {
+		return fmt.Errorf("malformed event %q", packet)
+	}
+	args = args[1:]
+
+	s := c.server
+	s.mu.Lock()
+	s.socketEvents = append(s.socketEvents, event)
+	res, after, known := c.dispatch(event, args)
+	s.mu.Unlock()
+
+	if !known {
+		// Like Kuma, ignore unknown events without acknowledging them.
+		return nil
+	}
+
+	if ackID != "" {
+		body, err := json.Marshal([]any{res})
+		if err != nil {
+			return err
+		}
+		if err := c.write("43" + ackID + string(body)); err != nil {
+			return err
+		}
+	}
+
+	for _, push := range after {
+		if err := c.emit(push.event, push.data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type socketPush struct {
+	event string
+	data  any
+}
+
+// dispatch handles an event and returns the acknowledgement and the events
+// to push afterwards. s.mu must be held.
+func (c *socketSession) dispatch(event string, args []json.RawMessage) (res any, after []socketPush, known bool) {
+	s := c.server
+
+	switch event {
+	case "login":
+		var req struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}
+		if !decodeArgs(args, &req) || req.Username != s.Username || req.Password != s.Password {
+			return fail("Incorrect username or password."), nil, true
+		}
+		token := newToken()
+		s.tokens[token] = true
+		c.loggedIn = true
+		// Kuma pushes the lists asynchronously, after acknowledging the login.
+		return map[string]any{"ok": true, "token": token}, c.lists(), true
+
+	case "loginByToken":
+		var token string
+		if !decodeArgs(args, &token) || !s.tokens[token] {
+			return fail("Invalid token"), nil, true
+		}
+		c.loggedIn = true
+		return map[string]any{"ok": true}, c.lists(), true
+	}
+
+	switch event {
+	case "getMonitor", "add", "editMonitor", "deleteMonitor", "addMonitorTag", "deleteMonitorTag",
+		"getTags", "addTag", "editTag", "deleteTag", "addDockerHost", "deleteDockerHost":
+	default:
+		return nil, nil, false
+	}
+
+	if !c.loggedIn {
+		return fail("You are not logged in."), nil, true
+	}
+
+	switch event {
+	case "getMonitor":
+		var id int64
+		if !decodeArgs(args, &id) {
+			return fail("invalid arguments"), nil, true
+		}
+		monitor, ok := s.monitors[id]
+		if !ok {
+			return fail("Cannot read properties of null (reading 'toJSON')"), nil, true
+		}
+		return map[string]any{"ok": true, "monitor": toWire(s.render(monitor))}, nil, true
+
+	case "add":
+		var wire wireMonitor
+		if !decodeArgs(args, &wire) {
+			return fail("invalid arguments"), nil, true
+		}
+		monitor := wire.monitor()
+		if errs := s.validateMonitor(monitor); len(errs) > 0 {
+			return fail(validationMessage(errs)), nil, true
+		}
+		id := s.addMonitor(monitor)
+		// Kuma pushes the new list before acknowledging.
+		if err := c.emit("monitorList", c.monitorList()); err != nil {
+			return fail(err.Error()), nil, true
+		}
+		return map[string]any{"ok": true, "msg": "Added Successfully.", "monitorID": id}, nil, true
+
+	case "editMonitor":
+		var wire wireMonitor
+		if !decodeArgs(args, &wire) {
+			return fail("invalid arguments"), nil, true
+		}
+		current, ok := s.monitors[wire.ID]
+		if !ok {
+			return fail("Cannot read properties of null (reading 'user_id')"), nil, true
+		}
+		updated := wire.monitor()
+		if errs := s.validateMonitor(updated); len(errs) > 0 {
+			return fail(validationMessage(errs)), nil, true
+		}
+		updated.Tags = current.Tags
+		updated.PathName = ""
+		updated.ChildrenIDs = nil
+		*current = updated
+		if err := c.emit("monitorList", c.monitorList()); err != nil {
+			return fail(err.Error()), nil, true
+		}
+		return map[string]any{"ok": true, "msg": "Saved Successfully.", "monitorID": current.ID}, nil, true
+
+	case "deleteMonitor":
+		var id int64
+		if !decodeArgs(args, &id) {
+			return fail("invalid arguments"), nil, true
+		}
+		delete(s.monitors, id)
+		for _, child := range s.monitors {
+			if child.Parent == id {
+				child.Parent = 0
+			}
+		}
+		// Kuma pushes the new list after acknowledging.
+		return map[string]any{"ok": true, "msg": "Deleted Successfully."}, []socketPush{{"monitorList", c.monitorList()}}, true
+
+	case "addMonitorTag", "deleteMonitorTag":
+		var tagID, monitorID int64
+		var value string
+		if len(args) < 3 || json.Unmarshal(args[0], &tagID) != nil || json.Unmarshal(args[1], &monitorID) != nil || json.Unmarshal(args[2], &value) != nil {
+			return fail("invalid arguments"), nil, true
+		}
+		monitor, ok := s.monitors[monitorID]
+		if !ok {
+			return fail("Monitor not found"), nil, true
+		}
+		if _, ok := s.tags[tagID]; !ok {
+			return fail("Tag not found"), nil, true
+		}
+		if event == "addMonitorTag" {
+			monitor.Tags = append(monitor.Tags, kuma.MonitorTag{TagId: tagID, Value: value})
+			return map[string]any{"ok": true, "msg": "Added Successfully."}, nil, true
+		}
+		monitor.Tags = slices.DeleteFunc(monitor.Tags, func(tag kuma.MonitorTag) bool {
+			return tag.TagId == tagID && tag.Value == value
+		})
+		return map[string]any{"ok": true, "msg": "Deleted Successfully."}, nil, true
+
+	case "getTags":
+		tags := make([]kuma.Tag, 0, len(s.tags))
+		for _, id := range sortedKeys(s.tags) {
+			tags = append(tags, *s.tags[id])
+		}
+		return map[string]any{"ok": true, "tags": tags}, nil, true
+
+	case "addTag", "editTag":
+		var tag kuma.Tag
+		if !decodeArgs(args, &tag) {
+			return fail("invalid arguments"), nil, true
+		}
+		if errs := validateTag(tag); len(errs) > 0 {
+			return fail(validationMessage(errs)), nil, true
+		}
+		if event == "addTag" {
+			s.nextTagID++
+			tag.ID = s.nextTagID
+		} else if _, ok := s.tags[tag.ID]; !ok {
+			return fail("Tag not found"), nil, true
+		}
+		s.tags[tag.ID] = &tag
+		return map[string]any{"ok": true, "tag": tag}, nil, true
+
+	case "addDockerHost":
+		var host kuma.DockerHost
+		var id *int64
+		if !decodeArgs(args, &host) || len(args) < 2 || json.Unmarshal(args[1], &id) != nil {
+			return fail("invalid arguments"), nil, true
+		}
+		if errs := validateDockerHost(host); len(errs) > 0 {
+			return fail(validationMessage(errs)), nil, true
+		}
+		if id == nil {
+			id = new(int64)
+		} else if _, ok := s.dockerHosts[*id]; !ok {
+			return fail("docker host not found"), nil, true
+		}
+		host = s.saveDockerHost(*id, host)
+		// Kuma pushes the new list before acknowledging.
+		if err := c.emit("dockerHostList", s.listDockerHosts()); err != nil {
+			return fail(err.Error()), nil, true
+		}
+		return map[string]any{"ok": true, "msg": "Saved.", "id": host.ID}, nil, true
+
+	case "deleteDockerHost":
+		var id int64
+		if !decodeArgs(args, &id) {
+			return fail("invalid arguments"), nil, true
+		}
+		s.deleteDockerHost(id)
+		if err := c.emit("dockerHostList", s.listDockerHosts()); err != nil {
+			return fail(err.Error()), nil, true
+		}
+		return map[string]any{"ok": true, "msg": "Deleted."}, nil, true
+
+	default: // deleteTag
+		var id int64
+		if !decodeArgs(args, &id) {
+			return fail("invalid arguments"), nil, true
+		}
+		delete(s.tags, id)
+		for _, monitor := range s.monitors {
+			monitor.Tags = slices.DeleteFunc(monitor.Tags, func(t kuma.MonitorTag) bool {
+				return t.TagId == id
+			})
+		}
+		return map[string]any{"ok": true, "msg": "Deleted Successfully."}, nil, true
+	}
+}
+
+// lists returns the pushes Kuma sends after sign-in. s.mu must be held.
+func (c *socketSession) lists() []socketPush {
+	s := c.server
+
+	notifications := make([]kuma.Notification, 0, len(s.notifications))
+	for _, id := range sortedKeys(s.notifications) {
+		notifications = append(notifications, *s.notifications[id])
+	}
+
+	return []socketPush{
+		{"info", s.info(true)},
+		{"monitorList", c.monitorList()},
+		{"notificationList", notifications},
+		{"dockerHostList", s.listDockerHosts()},
+	}
+}
+
+// monitorList returns the monitors keyed by ID. s.mu must be held.
+func (c *socketSession) monitorList() map[string]wireMonitor {
+	list := make(map[string]wireMonitor)
+	for _, monitor := range c.server.listMonitors() {
+		list[strconv.FormatInt(monitor.ID, 10)] = toWire(monitor)
+	}
+	return list
+}
+
+func (c *socketSession) emit(event string, data any) error {
+	body, err := json.Marshal([]any{event, data})
+	if err != nil {
+		return err
+	}
+	return c.write("42" + string(body))
+}
+
+func (c *socketSession) write(msg string) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	return c.ws.WriteMessage(websocket.TextMessage, []byte(msg))
+}
+
+func decodeArgs(args []json.RawMessage, v any) bool {
+	return len(args) > 0 && json.Unmarshal(args[0], v) == nil
+}
+
+func fail(msg string) map[string]any {
+	return map[string]any{"ok": false, "msg": msg}
+}
+
+func validationMessage(errs []validationError) string {
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, strings.Join(err.Loc[1:], ".")+": "+err.Msg)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func newToken() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
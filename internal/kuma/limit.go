@@ -0,0 +1,61 @@
+package kuma
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// WithConcurrencyLimit caps the number of requests in flight at once. Zero
+// or a negative value means unlimited.
+func WithConcurrencyLimit(maxInFlight int) ClientOption {
+	return func(c *Client) {
+		if maxInFlight <= 0 {
+			c.inflight = nil
+			return
+		}
+		c.inflight = make(chan struct{}, maxInFlight)
+	}
+}
+
+// WithRateLimit limits the client to requestsPerSecond using a token bucket
+// allowing short bursts of up to one second worth of requests. Zero or a
+// negative value means unlimited.
+func WithRateLimit(requestsPerSecond float64) ClientOption {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		burst := int(math.Max(1, math.Ceil(requestsPerSecond)))
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+}
+
+// acquire waits for a free request slot and a rate limit token. The returned
+// function must be called once the response has been read.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.inflight != nil {
+		select {
+		case c.inflight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if c.inflight != nil {
+			<-c.inflight
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
@@ -0,0 +1,76 @@
+package kuma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrencyLimit(t *testing.T) {
+	var inflight, peak atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inflight.Add(1)
+		defer inflight.Add(-1)
+
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"monitors": []}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil, WithConcurrencyLimit(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetMonitors(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestRateLimitHonorsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"monitors": []}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil, WithRateLimit(0.1), WithCacheTTL(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first request uses the burst, the second one would wait 10 seconds.
+	if _, err := client.GetMonitors(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetMonitors(ctx); err == nil {
+		t.Fatal("expected the rate limited request to be canceled")
+	}
+}
@@ -0,0 +1,168 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem of the HTTP trace. Set TF_LOG_PROVIDER_KUMA_HTTP
+// to control its level independently of the provider logs.
+const LogSubsystem = "kuma_http"
+
+const redacted = "***"
+
+// sensitiveKeys are the JSON keys whose values never appear in logs: every
+// Monitor field tagged `sensitive:"true"` plus the credentials of the auth flow.
+var sensitiveKeys = func() map[string]bool {
+	keys := map[string]bool{
+		"password":     true,
+		"token":        true,
+		"access_token": true,
+	}
+
+	t := reflect.TypeOf(Monitor{})
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get("sensitive") != "true" {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		keys[name] = true
+	}
+
+	return keys
+}()
+
+func newLogContext(ctx context.Context, token string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem)
+	return maskToken(ctx, token)
+}
+
+// maskToken hides the bearer token wherever it shows up in a logged value.
+func maskToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, token)
+}
+
+func logRequest(ctx context.Context, method, uri string, attempt int, contentType string, body []byte) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending request", map[string]any{
+		"method":       method,
+		"path":         uri,
+		"attempt":      attempt,
+		"request_body": redactBody(contentType, body),
+	})
+}
+
+func logResponse(ctx context.Context, method, uri string, status int, latency time.Duration, contentType string, body []byte) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received response", map[string]any{
+		"method":        method,
+		"path":          uri,
+		"status":        status,
+		"latency_ms":    latency.Milliseconds(),
+		"response_body": redactBody(contentType, body),
+	})
+}
+
+func logTransportError(ctx context.Context, method, uri string, latency time.Duration, err error) {
+	tflog.SubsystemWarn(ctx, LogSubsystem, "Request failed", map[string]any{
+		"method":     method,
+		"path":       uri,
+		"latency_ms": latency.Milliseconds(),
+		"error":      err.Error(),
+	})
+}
+
+func logEvent(ctx context.Context, event string, args []any) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending event", map[string]any{
+		"event": event,
+		"args":  redactArgs(args),
+	})
+}
+
+func logAck(ctx context.Context, event string, latency time.Duration, res []byte) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received acknowledgement", map[string]any{
+		"event":      event,
+		"latency_ms": latency.Milliseconds(),
+		"response":   redactBody("application/json", res),
+	})
+}
+
+func logEventError(ctx context.Context, event string, latency time.Duration, err error) {
+	tflog.SubsystemWarn(ctx, LogSubsystem, "Event failed", map[string]any{
+		"event":      event,
+		"latency_ms": latency.Milliseconds(),
+		"error":      err.Error(),
+	})
+}
+
+// redactArgs returns the arguments of a Socket.IO event as JSON with the
+// values of sensitiveKeys masked.
+func redactArgs(args []any) string {
+	body, err := json.Marshal(args)
+	if err != nil {
+		return redacted
+	}
+	return redactBody("application/json", body)
+}
+
+// redactBody returns body as a string with the values of sensitiveKeys masked.
+// Bodies that cannot be parsed are not logged at all, since they cannot be
+// redacted reliably.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return redacted
+		}
+		for key := range values {
+			if sensitiveKeys[key] {
+				values.Set(key, redacted)
+			}
+		}
+		return values.Encode()
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return redacted
+	}
+
+	out, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return redacted
+	}
+
+	return string(out)
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if sensitiveKeys[key] {
+				if item != nil && item != "" {
+					v[key] = redacted
+				}
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,41 @@
+package kuma
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	monitor := Monitor{
+		Name:                     "db",
+		BasicAuthPass:            "hunter2",
+		OAuthClientSecret:        "oauth-secret",
+		RADIUSSecret:             "radius-secret",
+		DatabaseConnectionString: "postgres://user:pass@db/app",
+	}
+
+	body, err := json.Marshal(map[string]any{"monitor": monitor})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := redactBody("application/json", body)
+	for _, secret := range []string{"hunter2", "oauth-secret", "radius-secret", "user:pass"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("secret %q leaked in %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"name":"db"`) {
+		t.Errorf("expected non-sensitive fields to be kept, got %s", got)
+	}
+
+	got = redactBody("application/x-www-form-urlencoded", []byte("username=admin&password=p%26ss&token=123456"))
+	if strings.Contains(got, "p%26ss") || strings.Contains(got, "123456") || !strings.Contains(got, "username=admin") {
+		t.Errorf("unexpected redacted form body %s", got)
+	}
+
+	if got := redactBody("text/plain", []byte("password=secret")); got != redacted {
+		t.Errorf("expected unparsable body to be hidden, got %s", got)
+	}
+}
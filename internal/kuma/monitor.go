@@ -1,15 +1,25 @@
 package kuma
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
-	"time"
 )
 
-func (c *Client) GetMonitors() ([]Monitor, error) {
-	body, _, err := c.doRequest("GET", "/monitors", nil)
+// GetMonitors returns all monitors. The list is cached for the lifetime set by WithCacheTTL.
+func (c *Client) GetMonitors(ctx context.Context) ([]Monitor, error) {
+	return cachedList(ctx, c, cacheKeyMonitors, c.fetchMonitors)
+}
+
+func (c *Client) fetchMonitors(ctx context.Context) ([]Monitor, error) {
+	if c.socket != nil {
+		return c.socket.getMonitors(ctx)
+	}
+
+	body, _, err := c.doRequest(ctx, "GET", "/monitors", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -27,8 +37,12 @@ func (c *Client) GetMonitors() ([]Monitor, error) {
 	return monitors.Monitors, nil
 }
 
-func (c *Client) GetMonitor(id int64) (*Monitor, error) {
-	body, _, err := c.doRequest("GET", "/monitors/"+strconv.FormatInt(id, 10), nil)
+func (c *Client) GetMonitor(ctx context.Context, id int64) (*Monitor, error) {
+	if c.socket != nil {
+		return c.socket.getMonitor(ctx, id)
+	}
+
+	body, _, err := c.doRequest(ctx, "GET", "/monitors/"+strconv.FormatInt(id, 10), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,14 +60,24 @@ func (c *Client) GetMonitor(id int64) (*Monitor, error) {
 	return &monitor.Monitor, nil
 }
 
-func (c *Client) CreateMonitor(monitor Monitor) (*int64, error) {
+func (c *Client) CreateMonitor(ctx context.Context, monitor Monitor) (*int64, error) {
+	if c.socket != nil {
+		id, err := c.socket.createMonitor(ctx, monitor)
+		c.invalidateCache(cacheKeyMonitors)
+		if err != nil {
+			return nil, err
+		}
+		return &id, nil
+	}
+
 	// Marshal the monitor
 	rb, err := json.Marshal(monitor)
 	if err != nil {
 		return nil, err
 	}
 
-	body, _, err := c.doRequest("POST", "/monitors", strings.NewReader(string(rb)))
+	body, _, err := c.doRequest(ctx, "POST", "/monitors", strings.NewReader(string(rb)))
+	c.invalidateCache(cacheKeyMonitors)
 	if err != nil {
 		return nil, err
 	}
@@ -72,32 +96,50 @@ func (c *Client) CreateMonitor(monitor Monitor) (*int64, error) {
 	return &resp.MonitorID, nil
 }
 
-func (c *Client) DeleteMonitor(id int64) error {
-	_, _, err := c.doRequest("DELETE", "/monitors/"+strconv.FormatInt(id, 10), nil)
+func (c *Client) DeleteMonitor(ctx context.Context, id int64) error {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyMonitors)
+		return c.socket.deleteMonitor(ctx, id)
+	}
+
+	_, _, err := c.doRequest(ctx, "DELETE", "/monitors/"+strconv.FormatInt(id, 10), nil)
+	c.invalidateCache(cacheKeyMonitors)
 	return err
 }
 
-func (c *Client) UpdateMonitor(monitorID int64, monitor Monitor) error {
+func (c *Client) UpdateMonitor(ctx context.Context, monitorID int64, monitor Monitor) error {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyMonitors)
+		return c.socket.updateMonitor(ctx, monitorID, monitor)
+	}
+
 	rb, err := json.Marshal(monitor)
 	if err != nil {
 		return err
 	}
 
-	_, _, err = c.doRequest("PATCH", "/monitors/"+strconv.FormatInt(monitorID, 10), strings.NewReader(string(rb)))
+	_, _, err = c.doRequest(ctx, "PATCH", "/monitors/"+strconv.FormatInt(monitorID, 10), strings.NewReader(string(rb)))
+	c.invalidateCache(cacheKeyMonitors)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (c *Client) CreateMonitorTag(monitorID int64, tagSet MonitorTag) error {
+func (c *Client) CreateMonitorTag(ctx context.Context, monitorID int64, tagSet MonitorTag) error {
 	tagSetup := make(map[string]any)
 
-	tag, err := c.GetTag(tagSet.Name)
+	tag, err := c.GetTag(ctx, tagSet.Name)
 	if err != nil {
 		return err
 	}
 
+	defer c.invalidateCache(cacheKeyMonitors)
+
+	if c.socket != nil {
+		return c.socket.addMonitorTag(ctx, tag.ID, monitorID, tagSet.Value)
+	}
+
 	tagSetup["tag_id"] = tag.ID
 	tagSetup["value"] = tagSet.Value
 
@@ -106,43 +148,42 @@ func (c *Client) CreateMonitorTag(monitorID int64, tagSet MonitorTag) error {
 		return err
 	}
 
-	for i := 0; ; i++ {
-		_, status, err := c.doRequest("POST", "/monitors/"+strconv.FormatInt(monitorID, 10)+"/tag", strings.NewReader(string(rb)))
-		switch *status {
-		case 200:
-			return nil
-		case 404:
-			return err
-		}
-
-		time.Sleep(c.Interval)
-
-		monitor, getErr := c.GetMonitor(monitorID)
-		if getErr != nil {
-			return getErr
-		}
+	_, status, err := c.doRequest(ctx, "POST", "/monitors/"+strconv.FormatInt(monitorID, 10)+"/tag", strings.NewReader(string(rb)))
+	if err == nil || status == nil || *status == http.StatusNotFound {
+		// Succeeded, never reached the server, or the monitor is gone.
+		return err
+	}
 
-		// Check if the tag already exists
-		for _, tag := range monitor.Tags {
-			if tag.Name == tagSet.Name {
-				return nil
-			}
-		}
+	// The wrapper rejects a tag that is already attached, for example by an
+	// apply that failed afterwards.
+	c.invalidateCache(cacheKeyMonitors)
+	monitor, getErr := c.GetMonitor(ctx, monitorID)
+	if getErr != nil {
+		return err
+	}
 
-		if i == int(c.Retry) {
-			return fmt.Errorf("failed to create tag after %d retries", err)
+	for _, tag := range monitor.Tags {
+		if tag.Name == tagSet.Name && tag.Value == tagSet.Value {
+			return nil
 		}
 	}
+
+	return err
 }
 
-func (c *Client) DeleteMonitorTag(monitorID int64, tagSet MonitorTag) (err error) {
+func (c *Client) DeleteMonitorTag(ctx context.Context, monitorID int64, tagSet MonitorTag) (err error) {
 	tagSetup := make(map[string]any)
 
-	curTag, err := c.GetTag(tagSet.Name)
+	curTag, err := c.GetTag(ctx, tagSet.Name)
 	if err != nil {
 		return err
 	}
 
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyMonitors)
+		return c.socket.deleteMonitorTag(ctx, curTag.ID, monitorID, tagSet.Value)
+	}
+
 	tagSetup["tag_id"] = curTag.ID
 	tagSetup["value"] = tagSet.Value
 
@@ -151,7 +192,31 @@ func (c *Client) DeleteMonitorTag(monitorID int64, tagSet MonitorTag) (err error
 		return err
 	}
 
-	_, _, err = c.doRequest("DELETE", "/monitors/"+strconv.FormatInt(monitorID, 10)+"/tag/", strings.NewReader(string(tag)))
+	_, _, err = c.doRequest(ctx, "DELETE", "/monitors/"+strconv.FormatInt(monitorID, 10)+"/tag/", strings.NewReader(string(tag)))
+	c.invalidateCache(cacheKeyMonitors)
 
 	return err
 }
+
+// PushURL returns the URL a push monitor with the token receives heartbeats
+// on, with the query the Kuma UI suggests. Like the UI, it is based on the
+// primary base URL. Without one it falls back to the address the client
+// connects to, but only with BackendSocketIO: with BackendREST that is the
+// address of the wrapper, and ErrNoPrimaryBaseURL is returned.
+func (c *Client) PushURL(ctx context.Context, token string) (string, error) {
+	info, err := c.GetServerInfo(ctx)
+
+	var base string
+	switch {
+	case err == nil && info.PrimaryBaseURL != "":
+		base = strings.TrimRight(info.PrimaryBaseURL, "/")
+	case c.socket != nil:
+		base = c.HostURL
+	case err != nil:
+		return "", err
+	default:
+		return "", ErrNoPrimaryBaseURL
+	}
+
+	return base + "/api/push/" + url.PathEscape(token) + "?status=up&msg=OK&ping=", nil
+}
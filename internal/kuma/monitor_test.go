@@ -1,42 +1,177 @@
-package kuma
+package kuma_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
 )
 
 func TestCreateMonitorTag(t *testing.T) {
-	host := "http://localhost:8000/"
-	usr := "admin"
-	pwd := "admin"
+	ctx := context.Background()
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
 
-	client, err := NewClient(&host, &usr, &pwd)
-	if err != nil {
-		t.Error(err)
-	}
+	server.AddTag(kuma.Tag{Name: "demo4", Color: "#2563EB"})
+	monitorID := server.AddMonitor(kuma.Monitor{Name: "example", Type: "http", Url: "https://example.com"})
 
-	tags := MonitorTag{
+	tags := kuma.MonitorTag{
 		Name:  "demo4",
 		Value: "789",
 	}
 
-	err = client.CreateMonitorTag(24, tags)
+	err := client.CreateMonitorTag(ctx, monitorID, tags)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+
+	monitor, err := client.GetMonitor(ctx, monitorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitor.Tags) != 1 || monitor.Tags[0].Name != "demo4" || monitor.Tags[0].Value != "789" {
+		t.Fatalf("unexpected monitor tags: %+v", monitor.Tags)
+	}
+}
+
+func TestCreateMonitorTagUnknownTag(t *testing.T) {
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	monitorID := server.AddMonitor(kuma.Monitor{Name: "example", Type: "http", Url: "https://example.com"})
+
+	err := client.CreateMonitorTag(context.Background(), monitorID, kuma.MonitorTag{Name: "missing", Value: "1"})
+	if !errors.Is(err, kuma.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
 	}
 }
 
 func TestDeleteMonitorTag(t *testing.T) {
-	host := "http://localhost:8000/"
-	usr := "admin"
-	pwd := "admin"
+	ctx := context.Background()
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	server.AddTag(kuma.Tag{Name: "demo", Color: "#2563EB"})
+	monitorID := server.AddMonitor(kuma.Monitor{Name: "example", Type: "http", Url: "https://example.com"})
+
+	tag := kuma.MonitorTag{Name: "demo", Value: "123"}
+
+	if err := client.CreateMonitorTag(ctx, monitorID, tag); err != nil {
+		t.Fatal(err)
+	}
+
+	err := client.DeleteMonitorTag(ctx, monitorID, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor(monitorID)
+	if len(monitor.Tags) != 0 {
+		t.Fatalf("expected tag to be removed, got %+v", monitor.Tags)
+	}
+}
+
+func TestMonitorLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	monitorID, err := client.CreateMonitor(ctx, kuma.Monitor{Name: "example", Type: "http", Url: "https://example.com", Interval: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
 
-	client, err := NewClient(&host, &usr, &pwd)
+	if err := client.UpdateMonitor(ctx, *monitorID, kuma.Monitor{Name: "renamed", Type: "http", Url: "https://example.org"}); err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, err := client.GetMonitor(ctx, *monitorID)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+
+	if monitor.Name != "renamed" || monitor.Url != "https://example.org" || monitor.Interval != 60 {
+		t.Fatalf("unexpected monitor after update: %+v", monitor)
 	}
 
-	err = client.DeleteMonitorTag(52, MonitorTag{TagId: 3, Value: "123"})
+	monitors, err := client.GetMonitors(ctx)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+
+	if len(monitors) != 1 {
+		t.Fatalf("expected 1 monitor, got %d", len(monitors))
+	}
+
+	if err := client.DeleteMonitor(ctx, *monitorID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetMonitor(ctx, *monitorID); !errors.Is(err, kuma.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestCreateMonitorValidation(t *testing.T) {
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	_, err := client.CreateMonitor(context.Background(), kuma.Monitor{Type: "http"})
+
+	var apiErr *kuma.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 422 {
+		t.Fatalf("expected a 422 API error, got %v", err)
+	}
+}
+
+func TestClientSignsInAgainAfterTokenExpiry(t *testing.T) {
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	server.ExpireTokens()
+
+	if _, err := client.GetNotifications(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPushURL(t *testing.T) {
+	tests := []struct {
+		name           string
+		backend        kuma.Backend
+		primaryBaseURL string
+		want           string
+		wantErr        error
+	}{
+		{"primary base URL", kuma.BackendREST, "https://status.example.com/", "https://status.example.com/api/push/abc123?status=up&msg=OK&ping=", nil},
+		// The REST host is the wrapper, not Kuma.
+		{"no primary base URL", kuma.BackendREST, "", "", kuma.ErrNoPrimaryBaseURL},
+		{"host fallback", kuma.BackendSocketIO, "", "%s/api/push/abc123?status=up&msg=OK&ping=", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := kumatest.NewServer(t)
+			server.PrimaryBaseURL = tt.primaryBaseURL
+			client := server.NewClient(t, kuma.WithBackend(tt.backend))
+
+			want := tt.want
+			if strings.Contains(want, "%s") {
+				want = fmt.Sprintf(want, server.URL)
+			}
+
+			got, err := client.PushURL(context.Background(), "abc123")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PushURL() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != want {
+				t.Fatalf("PushURL() = %q, want %q", got, want)
+			}
+		})
 	}
 }
@@ -1,13 +1,23 @@
 package kuma
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 )
 
-func (c *Client) GetNotifications() ([]Notification, error) {
-	resp, _, err := c.doRequest("GET", "/notifications", nil)
+// GetNotifications returns all notifications. The list is cached for the lifetime set by WithCacheTTL.
+func (c *Client) GetNotifications(ctx context.Context) ([]Notification, error) {
+	return cachedList(ctx, c, cacheKeyNotifications, c.fetchNotifications)
+}
+
+func (c *Client) fetchNotifications(ctx context.Context) ([]Notification, error) {
+	if c.socket != nil {
+		return c.socket.getNotifications(ctx)
+	}
+
+	resp, _, err := c.doRequest(ctx, "GET", "/notifications", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -25,8 +35,21 @@ func (c *Client) GetNotifications() ([]Notification, error) {
 	return notifications.Notifications, nil
 }
 
-func (c *Client) GetNotification(id int64) (*Notification, error) {
-	resp, _, err := c.doRequest("GET", fmt.Sprintf("/notifications/%s", strconv.FormatInt(id, 10)), nil)
+func (c *Client) GetNotification(ctx context.Context, id int64) (*Notification, error) {
+	if c.socket != nil {
+		notifications, err := c.GetNotifications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, notification := range notifications {
+			if notification.ID == id {
+				return &notification, nil
+			}
+		}
+		return nil, fmt.Errorf("notification %d: %w", id, ErrNotFound)
+	}
+
+	resp, _, err := c.doRequest(ctx, "GET", fmt.Sprintf("/notifications/%s", strconv.FormatInt(id, 10)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,9 +63,9 @@ func (c *Client) GetNotification(id int64) (*Notification, error) {
 	return &notification, nil
 }
 
-func (c *Client) GetDefaultNotifications() ([]int64, error) {
+func (c *Client) GetDefaultNotifications(ctx context.Context) ([]int64, error) {
 	var defaultNotifications []int64
-	notifications, err := c.GetNotifications()
+	notifications, err := c.GetNotifications(ctx)
 	if err != nil {
 		return nil, err
 	}
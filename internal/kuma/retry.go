@@ -0,0 +1,81 @@
+package kuma
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// shouldRetry reports whether a response with the given status is worth
+// retrying. Kuma and the reverse proxies in front of it answer with these
+// while restarting or when overloaded. Requests that are not idempotent are
+// only retried when the server rejected them, a gateway error may come after
+// the request was applied.
+func shouldRetry(statusCode int, idempotent bool) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+// notSent reports whether a transport error happened before the request was
+// written, so that the server cannot have applied it.
+func notSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header on res takes precedence, otherwise the wait grows exponentially from
+// RetryWaitMin up to RetryWaitMax with jitter applied.
+func (c *Client) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := c.RetryWaitMin
+	for i := 0; i < attempt && wait < c.RetryWaitMax; i++ {
+		wait *= 2
+	}
+	if wait > c.RetryWaitMax {
+		wait = c.RetryWaitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Pick a random wait in [wait/2, wait] so concurrent callers spread out.
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,143 @@
+package kuma
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRetriesUnavailable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"tags": []}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil, WithRetry(3, time.Millisecond, 5*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetTags(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestDoRequestGivesUpAfterRetryMax(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil, WithRetry(2, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetTags(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestDoRequestDoesNotRepeatPost(t *testing.T) {
+	tests := []struct {
+		status int
+		calls  int32
+	}{
+		// The gateway may have forwarded the request, retrying could create
+		// the tag twice.
+		{http.StatusBadGateway, 1},
+		{http.StatusGatewayTimeout, 1},
+		// The server rejected the request, it is safe to send it again.
+		{http.StatusServiceUnavailable, 3},
+		{http.StatusTooManyRequests, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(context.Background(), &server.URL, nil, nil, WithRetry(2, time.Millisecond, time.Millisecond))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := client.CreateTag(context.Background(), Tag{Name: "env", Color: "#2563EB"}); err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if got := calls.Load(); got != tt.calls {
+				t.Fatalf("expected %d calls, got %d", tt.calls, got)
+			}
+		})
+	}
+}
+
+func TestCreateMonitorTagIsNotRepeated(t *testing.T) {
+	var posts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tags":
+			_, _ = w.Write([]byte(`{"tags": [{"id": 1, "name": "env", "color": "#2563EB"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/monitors/1":
+			_, _ = w.Write([]byte(`{"monitor": {"id": 1, "name": "example", "tags": []}}`))
+		case r.Method == http.MethodPost:
+			posts.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(context.Background(), &server.URL, nil, nil, WithRetry(3, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.CreateMonitorTag(context.Background(), 1, MonitorTag{Name: "env", Value: "prod"}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if got := posts.Load(); got != 1 {
+		t.Fatalf("expected a single POST, got %d", got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := Client{RetryWaitMin: time.Second, RetryWaitMax: 4 * time.Second}
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		wait := c.backoff(attempt, nil)
+		if wait < max/2 || wait > max {
+			t.Errorf("attempt %d: wait %s not in [%s, %s]", attempt, wait, max/2, max)
+		}
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := c.backoff(0, res); wait != 7*time.Second {
+		t.Errorf("expected Retry-After to be respected, got %s", wait)
+	}
+}
@@ -0,0 +1,750 @@
+package kuma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Backend selects the API the client talks to.
+type Backend string
+
+const (
+	// BackendREST uses the Uptime Kuma REST API wrapper
+	// (https://github.com/MedAziz11/Uptime-Kuma-Web-API).
+	BackendREST Backend = "rest"
+	// BackendSocketIO uses the native Socket.IO API of Uptime Kuma, the one
+	// its web UI is built on, so that no wrapper has to be deployed.
+	BackendSocketIO Backend = "socketio"
+)
+
+// WithBackend selects the API used by the client, BackendREST by default.
+// With BackendSocketIO a token given to WithToken must be a token issued by
+// Uptime Kuma itself rather than by the wrapper.
+//
+// The Socket.IO connection is kept for the lifetime of the process: Terraform
+// stops the provider by killing it, there is no shutdown hook to close it.
+func WithBackend(backend Backend) ClientOption {
+	return func(c *Client) {
+		c.backend = backend
+	}
+}
+
+// SocketError is returned when Uptime Kuma rejects a Socket.IO event.
+type SocketError struct {
+	Event string
+	Msg   string
+}
+
+func (e *SocketError) Error() string {
+	return fmt.Sprintf("event %s: %s", e.Event, e.Msg)
+}
+
+// socketResponse is the common part of the acknowledgements sent by Kuma.
+type socketResponse struct {
+	OK  bool   `json:"ok"`
+	Msg string `json:"msg"`
+}
+
+// socketMonitor is a Monitor as exchanged over Socket.IO, where the
+// notifications are a set keyed by ID rather than a list.
+type socketMonitor struct {
+	Monitor
+	NotificationIDList map[string]bool `json:"notificationIDList"`
+}
+
+func (m socketMonitor) monitor() Monitor {
+	monitor := m.Monitor
+	monitor.NotificationIDList = nil
+
+	for key, enabled := range m.NotificationIDList {
+		if id, err := strconv.ParseInt(key, 10, 64); err == nil && enabled {
+			monitor.NotificationIDList = append(monitor.NotificationIDList, id)
+		}
+	}
+	slices.Sort(monitor.NotificationIDList)
+
+	return monitor
+}
+
+func notificationIDSet(ids []int64) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[strconv.FormatInt(id, 10)] = true
+	}
+	return set
+}
+
+// socketReadOnlyFields are computed by Kuma and rejected when adding a monitor.
+var socketReadOnlyFields = []string{"id", "pathName", "childrenIDs", "tags", "maintenance", "includeSensitiveData", "dns_last_result"}
+
+// socketUnmanagedFields are settings of a monitor the provider does not
+// manage. An edit keeps the values they have on the server.
+var socketUnmanagedFields = []string{
+	"active", "forceInactive", "weight", "timeout", "proxyId", "screenshot",
+	"authMethod", "authDomain", "authWorkstation", "basic_auth_user", "basic_auth_pass",
+	"oauth_auth_method", "oauth_client_id", "oauth_client_secret", "oauth_scopes", "oauth_token_url",
+	"tlsCa", "tlsCert", "tlsKey",
+	"mqttTopic", "mqttSuccessMessage", "mqttUsername", "mqttPassword",
+	"radiusCalledStationId", "radiusCallingStationId", "radiusUsername", "radiusPassword", "radiusSecret",
+	"game", "gamedigGivenPortOnly",
+	"kafkaProducerTopic", "kafkaProducerBrokers", "kafkaProducerSsl", "kafkaProducerAllowAutoTopicCreation",
+	"kafkaProducerMessage", "kafkaProducerSaslOptions",
+}
+
+// socketReferenceFields hold the ID of another object, Kuma expects null
+// rather than 0 when there is none.
+var socketReferenceFields = []string{"parent", "docker_host"}
+
+// socketSession is a signed-in connection together with the lists Kuma
+// pushes to it. A new session is started whenever the connection is lost.
+type socketSession struct {
+	conn *socketConn
+
+	mu                 sync.Mutex
+	monitors           map[int64]Monitor
+	monitorsReady      chan struct{}
+	notifications      []Notification
+	notificationsReady chan struct{}
+	info               *ServerInfo
+	infoReady          chan struct{}
+	dockerHosts        []DockerHost
+	dockerHostsReady   chan struct{}
+}
+
+func newSocketSession() *socketSession {
+	return &socketSession{
+		monitorsReady:      make(chan struct{}),
+		notificationsReady: make(chan struct{}),
+		infoReady:          make(chan struct{}),
+		dockerHostsReady:   make(chan struct{}),
+	}
+}
+
+func (s *socketSession) handlers() map[string]socketEventHandler {
+	return map[string]socketEventHandler{
+		"monitorList":      s.setMonitors,
+		"notificationList": s.setNotifications,
+		"info":             s.setInfo,
+		"dockerHostList":   s.setDockerHosts,
+	}
+}
+
+func (s *socketSession) setMonitors(args []json.RawMessage) {
+	if len(args) == 0 {
+		return
+	}
+
+	var list map[string]socketMonitor
+	if err := json.Unmarshal(args[0], &list); err != nil {
+		return
+	}
+
+	monitors := make(map[int64]Monitor, len(list))
+	for _, m := range list {
+		monitors[m.ID] = m.monitor()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.monitors = monitors
+	closeOnce(s.monitorsReady)
+}
+
+func (s *socketSession) setNotifications(args []json.RawMessage) {
+	if len(args) == 0 {
+		return
+	}
+
+	var notifications []Notification
+	if err := json.Unmarshal(args[0], &notifications); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.notifications = notifications
+	closeOnce(s.notificationsReady)
+}
+
+func (s *socketSession) setDockerHosts(args []json.RawMessage) {
+	if len(args) == 0 {
+		return
+	}
+
+	var hosts []DockerHost
+	if err := json.Unmarshal(args[0], &hosts); err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dockerHosts = hosts
+	closeOnce(s.dockerHostsReady)
+}
+
+// setInfo stores the server info. Kuma sends it without the version before
+// sign-in, and again in full afterwards.
+func (s *socketSession) setInfo(args []json.RawMessage) {
+	if len(args) == 0 {
+		return
+	}
+
+	var info ServerInfo
+	if err := json.Unmarshal(args[0], &info); err != nil || info.Version == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.info = &info
+	closeOnce(s.infoReady)
+}
+
+func closeOnce(ch chan struct{}) {
+	select {
+	case <-ch:
+	default:
+		close(ch)
+	}
+}
+
+// wait blocks until ready is closed by the first push of a list.
+func (s *socketSession) wait(ctx context.Context, ready chan struct{}) error {
+	select {
+	case <-ready:
+		return nil
+	case <-s.conn.done:
+		return s.conn.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// socketBackend implements the client operations over Socket.IO.
+type socketBackend struct {
+	c      *Client
+	dialer *websocket.Dialer
+
+	// mu serializes connecting, session is the current connection.
+	mu      sync.Mutex
+	session *socketSession
+}
+
+// current returns the signed-in session, connecting first if needed.
+// Connection failures are retried, rejected credentials are not.
+func (b *socketBackend) current(ctx context.Context) (*socketSession, error) {
+	for attempt := 0; ; attempt++ {
+		session, retry, err := b.connect(ctx)
+		if err == nil || !retry || ctx.Err() != nil || attempt >= b.c.RetryMax {
+			return session, err
+		}
+
+		if err := sleep(ctx, b.c.backoff(attempt, nil)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (b *socketBackend) connect(ctx context.Context) (*socketSession, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.session != nil && !b.session.conn.closed() {
+		return b.session, false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, b.c.transport.timeout())
+	defer cancel()
+
+	session := newSocketSession()
+
+	conn, err := dialSocket(ctx, b.dialer, b.c.HostURL, session.handlers())
+	if err != nil {
+		return nil, true, err
+	}
+	session.conn = conn
+
+	if err := b.login(ctx, session); err != nil {
+		conn.close(errSocketClosed)
+		return nil, false, err
+	}
+
+	b.session = session
+
+	return session, false, nil
+}
+
+// login authenticates the session with the credentials when set, falling
+// back to the token.
+func (b *socketBackend) login(ctx context.Context, session *socketSession) error {
+	if !b.c.canReauth() {
+		token := b.c.token()
+		if token == "" {
+			return fmt.Errorf("define username and password or token")
+		}
+
+		var res socketResponse
+		if _, err := b.emit(ctx, session, &res, "loginByToken", token); err != nil {
+			return err
+		}
+		if !res.OK {
+			return &SocketError{Event: "loginByToken", Msg: res.Msg}
+		}
+		return nil
+	}
+
+	code, err := b.c.Auth.totpCode(time.Now())
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		socketResponse
+		Token         string `json:"token"`
+		TokenRequired bool   `json:"tokenRequired"`
+	}
+
+	_, err = b.emit(ctx, session, &res, "login", map[string]string{
+		"username": b.c.Auth.Username,
+		"password": b.c.Auth.Password,
+		"token":    code,
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.TokenRequired {
+		if code == "" {
+			return ErrTOTPRequired
+		}
+		return fmt.Errorf("two-factor code was rejected")
+	}
+
+	if !res.OK {
+		return &SocketError{Event: "login", Msg: res.Msg}
+	}
+
+	b.c.tokenMu.Lock()
+	b.c.Token = res.Token
+	b.c.tokenMu.Unlock()
+
+	return nil
+}
+
+// emit sends event on session and decodes the acknowledgement into out. sent
+// reports whether the event may have reached the server.
+func (b *socketBackend) emit(ctx context.Context, session *socketSession, out any, event string, args ...any) (sent bool, err error) {
+	ctx = newLogContext(ctx, b.c.token())
+
+	ctx, cancel := context.WithTimeout(ctx, b.c.transport.timeout())
+	defer cancel()
+
+	release, err := b.c.acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	logEvent(ctx, event, args)
+
+	start := time.Now()
+	res, sent, err := session.conn.emit(ctx, event, args...)
+	if err != nil {
+		logEventError(ctx, event, time.Since(start), err)
+		return sent, fmt.Errorf("event %s: %w", event, err)
+	}
+
+	logAck(ctx, event, time.Since(start), res)
+
+	if out == nil {
+		return true, nil
+	}
+
+	return true, json.Unmarshal(res, out)
+}
+
+// socketReadEvents have no side effects and can be sent again even when the
+// connection was lost after sending them.
+var socketReadEvents = map[string]bool{
+	"getMonitor": true,
+	"getTags":    true,
+}
+
+// call emits event on the current session, reconnecting and sending it again
+// unless it may already have been applied. It fails with a SocketError when
+// Kuma answers with ok false.
+func (b *socketBackend) call(ctx context.Context, out any, event string, args ...any) error {
+	for attempt := 0; ; attempt++ {
+		session, err := b.current(ctx)
+		if err != nil {
+			return err
+		}
+
+		var raw json.RawMessage
+		sent, err := b.emit(ctx, session, &raw, event, args...)
+		if err == nil {
+			var res socketResponse
+			if err := json.Unmarshal(raw, &res); err != nil {
+				return fmt.Errorf("event %s: %w", event, err)
+			}
+			if !res.OK {
+				return &SocketError{Event: event, Msg: res.Msg}
+			}
+			if out == nil {
+				return nil
+			}
+			return json.Unmarshal(raw, out)
+		}
+
+		if (sent && !socketReadEvents[event]) || ctx.Err() != nil || attempt >= b.c.RetryMax {
+			return err
+		}
+
+		if err := sleep(ctx, b.c.backoff(attempt, nil)); err != nil {
+			return err
+		}
+	}
+}
+
+// getMonitors returns the monitor list last pushed by Kuma, which sends it
+// after sign-in and after every change.
+func (b *socketBackend) getMonitors(ctx context.Context) ([]Monitor, error) {
+	session, err := b.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.wait(ctx, session.monitorsReady); err != nil {
+		return nil, fmt.Errorf("wait for monitor list: %w", err)
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	monitors := make([]Monitor, 0, len(session.monitors))
+	for _, id := range slices.Sorted(maps.Keys(session.monitors)) {
+		monitors = append(monitors, session.monitors[id])
+	}
+
+	return monitors, nil
+}
+
+func (b *socketBackend) getMonitor(ctx context.Context, id int64) (*Monitor, error) {
+	var res struct {
+		Monitor socketMonitor `json:"monitor"`
+	}
+
+	if err := b.call(ctx, &res, "getMonitor", id); err != nil {
+		// Kuma does not tell a missing monitor apart from other failures,
+		// check the monitor list instead.
+		var socketErr *SocketError
+		if errors.As(err, &socketErr) && !b.hasMonitor(ctx, id) {
+			return nil, fmt.Errorf("monitor %d: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+
+	monitor := res.Monitor.monitor()
+
+	return &monitor, nil
+}
+
+func (b *socketBackend) hasMonitor(ctx context.Context, id int64) bool {
+	monitors, err := b.getMonitors(ctx)
+	if err != nil {
+		return true
+	}
+
+	return slices.ContainsFunc(monitors, func(m Monitor) bool { return m.ID == id })
+}
+
+func (b *socketBackend) createMonitor(ctx context.Context, monitor Monitor) (int64, error) {
+	payload, err := socketMonitorPayload(monitor)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, field := range socketReadOnlyFields {
+		delete(payload, field)
+	}
+
+	// Kuma stores the accepted status codes as given and fails to check the
+	// monitor when there are none.
+	if _, ok := payload["accepted_statuscodes"]; !ok {
+		payload["accepted_statuscodes"] = []string{"200-299"}
+	}
+
+	var res struct {
+		MonitorID int64 `json:"monitorID"`
+	}
+
+	if err := b.call(ctx, &res, "add", payload); err != nil {
+		return 0, err
+	}
+
+	return res.MonitorID, nil
+}
+
+// updateMonitor applies monitor on top of the current one, since Kuma
+// replaces the whole monitor on edit. Every managed field is sent, zero
+// values included, so that a setting can be turned off or cleared.
+func (b *socketBackend) updateMonitor(ctx context.Context, id int64, monitor Monitor) error {
+	var current struct {
+		Monitor map[string]any `json:"monitor"`
+	}
+
+	if err := b.call(ctx, &current, "getMonitor", id); err != nil {
+		return err
+	}
+
+	merged := current.Monitor
+	for field, value := range socketMonitorFields(monitor) {
+		if slices.Contains(socketReadOnlyFields, field) || slices.Contains(socketUnmanagedFields, field) {
+			continue
+		}
+		merged[field] = value
+	}
+	merged["id"] = id
+
+	return b.call(ctx, nil, "editMonitor", merged)
+}
+
+func (b *socketBackend) deleteMonitor(ctx context.Context, id int64) error {
+	if err := b.call(ctx, nil, "deleteMonitor", id); err != nil {
+		return err
+	}
+
+	// Kuma pushes the new monitor list only after acknowledging the deletion.
+	b.updateSessionMonitor(id, nil)
+
+	return nil
+}
+
+func (b *socketBackend) addMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	if err := b.call(ctx, nil, "addMonitorTag", tagID, monitorID, value); err != nil {
+		return err
+	}
+
+	b.refreshMonitor(ctx, monitorID)
+
+	return nil
+}
+
+func (b *socketBackend) deleteMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	if err := b.call(ctx, nil, "deleteMonitorTag", tagID, monitorID, value); err != nil {
+		return err
+	}
+
+	b.refreshMonitor(ctx, monitorID)
+
+	return nil
+}
+
+// refreshMonitor updates the pushed monitor list after a change Kuma does
+// not push, such as a tag change.
+func (b *socketBackend) refreshMonitor(ctx context.Context, id int64) {
+	monitor, err := b.getMonitor(ctx, id)
+	if err != nil {
+		return
+	}
+
+	b.updateSessionMonitor(id, monitor)
+}
+
+// updateSessionMonitor replaces a monitor of the pushed list, or removes it
+// when monitor is nil.
+func (b *socketBackend) updateSessionMonitor(id int64, monitor *Monitor) {
+	b.mu.Lock()
+	session := b.session
+	b.mu.Unlock()
+
+	if session == nil {
+		return
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.monitors == nil {
+		return
+	}
+
+	if monitor == nil {
+		delete(session.monitors, id)
+		return
+	}
+
+	session.monitors[id] = *monitor
+}
+
+func (b *socketBackend) getTags(ctx context.Context) ([]Tag, error) {
+	var res struct {
+		Tags []Tag `json:"tags"`
+	}
+
+	if err := b.call(ctx, &res, "getTags"); err != nil {
+		return nil, err
+	}
+
+	return res.Tags, nil
+}
+
+func (b *socketBackend) createTag(ctx context.Context, tag Tag) (*Tag, error) {
+	var res struct {
+		Tag Tag `json:"tag"`
+	}
+
+	if err := b.call(ctx, &res, "addTag", tag); err != nil {
+		return nil, err
+	}
+
+	return &res.Tag, nil
+}
+
+func (b *socketBackend) updateTag(ctx context.Context, id int64, tag Tag) error {
+	tag.ID = id
+
+	return b.call(ctx, nil, "editTag", tag)
+}
+
+func (b *socketBackend) deleteTag(ctx context.Context, id int64) error {
+	return b.call(ctx, nil, "deleteTag", id)
+}
+
+// getNotifications returns the notification list last pushed by Kuma.
+func (b *socketBackend) getNotifications(ctx context.Context) ([]Notification, error) {
+	session, err := b.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.wait(ctx, session.notificationsReady); err != nil {
+		return nil, fmt.Errorf("wait for notification list: %w", err)
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	return slices.Clone(session.notifications), nil
+}
+
+// getDockerHosts returns the docker host list last pushed by Kuma.
+func (b *socketBackend) getDockerHosts(ctx context.Context) ([]DockerHost, error) {
+	session, err := b.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.wait(ctx, session.dockerHostsReady); err != nil {
+		return nil, fmt.Errorf("wait for docker host list: %w", err)
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	return slices.Clone(session.dockerHosts), nil
+}
+
+// saveDockerHost adds a docker host, or edits it when id is set. Kuma pushes
+// the new list before acknowledging.
+func (b *socketBackend) saveDockerHost(ctx context.Context, id *int64, host DockerHost) (*DockerHost, error) {
+	var res struct {
+		ID int64 `json:"id"`
+	}
+
+	host.ID = 0
+
+	if err := b.call(ctx, &res, "addDockerHost", host, id); err != nil {
+		return nil, err
+	}
+
+	host.ID = res.ID
+
+	return &host, nil
+}
+
+func (b *socketBackend) deleteDockerHost(ctx context.Context, id int64) error {
+	return b.call(ctx, nil, "deleteDockerHost", id)
+}
+
+// getServerInfo returns the server info pushed by Kuma after sign-in.
+func (b *socketBackend) getServerInfo(ctx context.Context) (*ServerInfo, error) {
+	session, err := b.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := session.wait(ctx, session.infoReady); err != nil {
+		return nil, fmt.Errorf("wait for server info: %w", err)
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	info := *session.info
+
+	return &info, nil
+}
+
+// socketMonitorPayload returns the set fields of monitor in the shape Kuma
+// expects.
+func socketMonitorPayload(monitor Monitor) (map[string]any, error) {
+	rb, err := json.Marshal(monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(rb, &payload); err != nil {
+		return nil, err
+	}
+
+	if _, ok := payload["notificationIDList"]; ok {
+		payload["notificationIDList"] = notificationIDSet(monitor.NotificationIDList)
+	}
+
+	return payload, nil
+}
+
+// socketMonitorFields returns every field of monitor keyed by its JSON name,
+// zero values included. Nil pointers and lists are left out, they leave the
+// value on the server unchanged.
+func socketMonitorFields(monitor Monitor) map[string]any {
+	fields := make(map[string]any)
+
+	value := reflect.ValueOf(monitor)
+	for i := range value.NumField() {
+		name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+		field := value.Field(i)
+
+		switch {
+		case name == "" || name == "-":
+			continue
+		case (field.Kind() == reflect.Pointer || field.Kind() == reflect.Slice) && field.IsNil():
+			continue
+		case slices.Contains(socketReferenceFields, name) && field.IsZero():
+			fields[name] = nil
+		default:
+			fields[name] = field.Interface()
+		}
+	}
+
+	fields["notificationIDList"] = notificationIDSet(monitor.NotificationIDList)
+
+	return fields
+}
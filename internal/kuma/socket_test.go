@@ -0,0 +1,191 @@
+package kuma_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+)
+
+func TestSocketMonitorLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t, kuma.WithBackend(kuma.BackendSocketIO), kuma.WithCacheTTL(0))
+
+	notification := server.AddNotification(kuma.Notification{Name: "ops", Type: "slack", Active: true})
+	server.AddTag(kuma.Tag{Name: "env", Color: "#2563EB"})
+
+	id, err := client.CreateMonitor(ctx, kuma.Monitor{
+		Name:               "example",
+		Type:               "http",
+		Url:                "https://example.com",
+		Interval:           60,
+		NotificationIDList: []int64{notification.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, err := client.GetMonitor(ctx, *id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if monitor.Name != "example" || !slices.Equal(monitor.NotificationIDList, []int64{notification.ID}) {
+		t.Fatalf("unexpected monitor: %+v", monitor)
+	}
+	if !slices.Equal(monitor.AcceptedStatusCodes, []string{"200-299"}) {
+		t.Fatalf("expected default accepted status codes, got %v", monitor.AcceptedStatusCodes)
+	}
+
+	// Every managed field is sent, zero values included, the settings the
+	// provider does not manage are kept.
+	server.UpdateMonitor(*id, func(m *kuma.Monitor) { m.Timeout = 48 })
+
+	update := *monitor
+	update.Name = "renamed"
+	update.Url = "https://example.org"
+	update.NotificationIDList = nil
+	if err := client.UpdateMonitor(ctx, *id, update); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, _ := server.Monitor(*id)
+	if stored.Name != "renamed" || stored.Url != "https://example.org" || stored.Interval != 60 || stored.Type != "http" || stored.Timeout != 48 {
+		t.Fatalf("unexpected monitor after update: %+v", stored)
+	}
+	if len(stored.NotificationIDList) != 0 {
+		t.Fatalf("expected the notifications to be removed, got %v", stored.NotificationIDList)
+	}
+
+	if err := client.CreateMonitorTag(ctx, *id, kuma.MonitorTag{Name: "env", Value: "prod"}); err != nil {
+		t.Fatal(err)
+	}
+
+	monitors, err := client.GetMonitors(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 1 || len(monitors[0].Tags) != 1 || monitors[0].Tags[0].Value != "prod" {
+		t.Fatalf("unexpected monitor list: %+v", monitors)
+	}
+
+	if err := client.DeleteMonitorTag(ctx, *id, kuma.MonitorTag{Name: "env", Value: "prod"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteMonitor(ctx, *id); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetMonitor(ctx, *id); !errors.Is(err, kuma.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	monitors, err = client.GetMonitors(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 0 {
+		t.Fatalf("expected no monitors, got %+v", monitors)
+	}
+
+	want := []string{"login", "add", "getMonitor", "getMonitor", "editMonitor", "getTags", "addMonitorTag", "getMonitor", "getTags", "deleteMonitorTag", "getMonitor", "deleteMonitor", "getMonitor"}
+	if events := server.SocketEvents(); !slices.Equal(events, want) {
+		t.Fatalf("unexpected events:\n got %v\nwant %v", events, want)
+	}
+}
+
+func TestSocketTagsAndNotifications(t *testing.T) {
+	ctx := context.Background()
+	server := kumatest.NewServer(t)
+	// Kuma pushes the notification list at sign-in.
+	server.AddNotification(kuma.Notification{Name: "ops", Type: "slack", Active: true, IsDefault: true})
+	client := server.NewClient(t, kuma.WithBackend(kuma.BackendSocketIO))
+
+	tag, err := client.CreateTag(ctx, kuma.Tag{Name: "env", Color: "#2563EB"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.UpdateTag(ctx, tag.ID, kuma.Tag{Name: "env", Color: "#059669"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.GetTag(ctx, "env")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Color != "#059669" {
+		t.Fatalf("unexpected tag: %+v", got)
+	}
+
+	if err := client.DeleteTag(ctx, tag.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetTag(ctx, "env"); !errors.Is(err, kuma.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	defaults, err := client.GetDefaultNotifications(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(defaults) != 1 {
+		t.Fatalf("expected one default notification, got %v", defaults)
+	}
+}
+
+func TestSocketRejectsEvent(t *testing.T) {
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t, kuma.WithBackend(kuma.BackendSocketIO))
+
+	_, err := client.CreateMonitor(context.Background(), kuma.Monitor{Name: "example", Type: "http"})
+
+	var socketErr *kuma.SocketError
+	if !errors.As(err, &socketErr) || socketErr.Event != "add" {
+		t.Fatalf("expected SocketError for add, got %v", err)
+	}
+}
+
+func TestSocketLoginFailure(t *testing.T) {
+	server := kumatest.NewServer(t)
+	password := "wrong"
+
+	_, err := kuma.NewClient(context.Background(), &server.URL, &server.Username, &password, kuma.WithBackend(kuma.BackendSocketIO), kuma.WithRetry(0, 0, 0))
+
+	var socketErr *kuma.SocketError
+	if !errors.As(err, &socketErr) || socketErr.Event != "login" {
+		t.Fatalf("expected SocketError for login, got %v", err)
+	}
+}
+
+func TestSocketReconnects(t *testing.T) {
+	ctx := context.Background()
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t, kuma.WithBackend(kuma.BackendSocketIO), kuma.WithRetry(2, 0, 0))
+
+	server.CloseSockets()
+
+	if _, err := client.GetTags(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The token issued at the first sign-in is enough to sign in again.
+	tokenClient, err := kuma.NewClient(ctx, &server.URL, nil, nil, kuma.WithBackend(kuma.BackendSocketIO), kuma.WithToken(client.Token))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tokenClient.GetMonitors(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"login", "login", "getTags", "loginByToken"}
+	if events := server.SocketEvents(); !slices.Equal(events, want) {
+		t.Fatalf("unexpected events:\n got %v\nwant %v", events, want)
+	}
+}
@@ -0,0 +1,333 @@
+package kuma
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Engine.IO v4 packet types, see https://github.com/socketio/engine.io-protocol.
+const (
+	eioOpen    = '0'
+	eioClose   = '1'
+	eioPing    = '2'
+	eioPong    = '3'
+	eioMessage = '4'
+)
+
+// Socket.IO v5 packet types, see https://github.com/socketio/socket.io-protocol.
+const (
+	sioConnect      = '0'
+	sioDisconnect   = '1'
+	sioEvent        = '2'
+	sioAck          = '3'
+	sioConnectError = '4'
+)
+
+var errSocketClosed = errors.New("socket.io connection closed")
+
+// socketEventHandler receives the arguments of an event pushed by the server.
+type socketEventHandler func(args []json.RawMessage)
+
+// socketConn is a Socket.IO client connection to the default namespace over
+// a plain websocket, without the HTTP long-polling fallback.
+type socketConn struct {
+	ws       *websocket.Conn
+	handlers map[string]socketEventHandler
+	// idleTimeout is how long the connection may stay silent before it is
+	// considered dead. The server pings every pingInterval.
+	idleTimeout time.Duration
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	nextAck int
+	acks    map[int]chan json.RawMessage
+	err     error
+	done    chan struct{}
+}
+
+// socketURL returns the Socket.IO endpoint of the Kuma instance at host.
+func socketURL(host string) (string, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported scheme %q in host %s", u.Scheme, host)
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/") + "/socket.io/"
+	u.RawQuery = url.Values{"EIO": {"4"}, "transport": {"websocket"}}.Encode()
+
+	return u.String(), nil
+}
+
+// dialSocket connects to the Socket.IO server at host and joins the default
+// namespace. Events pushed by the server are dispatched to handlers from a
+// single goroutine, in the order they were received.
+func dialSocket(ctx context.Context, dialer *websocket.Dialer, host string, handlers map[string]socketEventHandler) (*socketConn, error) {
+	endpoint, err := socketURL(host)
+	if err != nil {
+		return nil, err
+	}
+
+	ws, res, err := dialer.DialContext(ctx, endpoint, nil)
+	if err != nil {
+		if res != nil {
+			return nil, fmt.Errorf("connect to %s: %w (status %d)", endpoint, err, res.StatusCode)
+		}
+		return nil, fmt.Errorf("connect to %s: %w", endpoint, err)
+	}
+
+	s := &socketConn{
+		ws:       ws,
+		handlers: handlers,
+		acks:     make(map[int]chan json.RawMessage),
+		done:     make(chan struct{}),
+	}
+
+	if err := s.handshake(ctx); err != nil {
+		ws.Close()
+		return nil, err
+	}
+
+	go s.readLoop()
+
+	return s, nil
+}
+
+func (s *socketConn) handshake(ctx context.Context) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.ws.SetReadDeadline(deadline)
+		defer s.ws.SetReadDeadline(time.Time{})
+	}
+
+	_, data, err := s.ws.ReadMessage()
+	if err != nil {
+		return fmt.Errorf("socket.io handshake: %w", err)
+	}
+
+	if len(data) == 0 || data[0] != eioOpen {
+		return fmt.Errorf("socket.io handshake: unexpected packet %q", data)
+	}
+
+	var open struct {
+		PingInterval int64 `json:"pingInterval"`
+		PingTimeout  int64 `json:"pingTimeout"`
+	}
+	if err := json.Unmarshal(data[1:], &open); err != nil {
+		return fmt.Errorf("socket.io handshake: %w", err)
+	}
+	s.idleTimeout = time.Duration(open.PingInterval+open.PingTimeout) * time.Millisecond
+
+	if err := s.write(string([]byte{eioMessage, sioConnect})); err != nil {
+		return fmt.Errorf("socket.io handshake: %w", err)
+	}
+
+	for {
+		_, data, err := s.ws.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("socket.io handshake: %w", err)
+		}
+
+		switch {
+		case len(data) == 1 && data[0] == eioPing:
+			if err := s.write(string(eioPong)); err != nil {
+				return fmt.Errorf("socket.io handshake: %w", err)
+			}
+		case len(data) > 1 && data[0] == eioMessage && data[1] == sioConnect:
+			return nil
+		case len(data) > 1 && data[0] == eioMessage && data[1] == sioConnectError:
+			return fmt.Errorf("socket.io handshake: connection refused: %s", data[2:])
+		default:
+			return fmt.Errorf("socket.io handshake: unexpected packet %q", data)
+		}
+	}
+}
+
+func (s *socketConn) readLoop() {
+	for {
+		if s.idleTimeout > 0 {
+			_ = s.ws.SetReadDeadline(time.Now().Add(s.idleTimeout))
+		}
+
+		_, data, err := s.ws.ReadMessage()
+		if err != nil {
+			s.close(err)
+			return
+		}
+
+		if len(data) == 0 {
+			continue
+		}
+
+		switch data[0] {
+		case eioPing:
+			if err := s.write(string(eioPong)); err != nil {
+				s.close(err)
+				return
+			}
+		case eioClose:
+			s.close(errSocketClosed)
+			return
+		case eioMessage:
+			if err := s.handlePacket(data[1:]); err != nil {
+				s.close(err)
+				return
+			}
+		}
+	}
+}
+
+func (s *socketConn) handlePacket(packet []byte) error {
+	if len(packet) == 0 {
+		return nil
+	}
+
+	kind, rest := packet[0], packet[1:]
+
+	// Skip the namespace, only the default one is used.
+	if len(rest) > 0 && rest[0] == '/' {
+		if i := bytes.IndexByte(rest, ','); i >= 0 {
+			rest = rest[i+1:]
+		}
+	}
+
+	digits := 0
+	for digits < len(rest) && rest[digits] >= '0' && rest[digits] <= '9' {
+		digits++
+	}
+	ackID := -1
+	if digits > 0 {
+		ackID, _ = strconv.Atoi(string(rest[:digits]))
+	}
+	rest = rest[digits:]
+
+	switch kind {
+	case sioEvent:
+		var args []json.RawMessage
+		if err := json.Unmarshal(rest, &args); err != nil || len(args) == 0 {
+			return fmt.Errorf("malformed socket.io event %q", packet)
+		}
+
+		var event string
+		if err := json.Unmarshal(args[0], &event); err != nil {
+			return fmt.Errorf("malformed socket.io event %q", packet)
+		}
+
+		if handler, ok := s.handlers[event]; ok {
+			handler(args[1:])
+		}
+	case sioAck:
+		var args []json.RawMessage
+		if err := json.Unmarshal(rest, &args); err != nil {
+			return fmt.Errorf("malformed socket.io acknowledgement %q", packet)
+		}
+
+		s.mu.Lock()
+		ch, ok := s.acks[ackID]
+		delete(s.acks, ackID)
+		s.mu.Unlock()
+
+		if ok {
+			var res json.RawMessage
+			if len(args) > 0 {
+				res = args[0]
+			}
+			ch <- res
+		}
+	case sioDisconnect:
+		return errSocketClosed
+	}
+
+	return nil
+}
+
+// emit sends an event and waits for the server to acknowledge it, returning
+// the first argument of the acknowledgement. sent reports whether the event
+// may have reached the server, in which case it must not be sent again.
+func (s *socketConn) emit(ctx context.Context, event string, args ...any) (res json.RawMessage, sent bool, err error) {
+	payload, err := json.Marshal(append([]any{event}, args...))
+	if err != nil {
+		return nil, false, err
+	}
+
+	ch := make(chan json.RawMessage, 1)
+
+	s.mu.Lock()
+	if s.err != nil {
+		s.mu.Unlock()
+		return nil, false, s.err
+	}
+	id := s.nextAck
+	s.nextAck++
+	s.acks[id] = ch
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.acks, id)
+		s.mu.Unlock()
+	}()
+
+	if err := s.write(fmt.Sprintf("%c%c%d%s", eioMessage, sioEvent, id, payload)); err != nil {
+		s.close(err)
+		return nil, false, err
+	}
+
+	select {
+	case res := <-ch:
+		return res, true, nil
+	case <-s.done:
+		return nil, true, s.err
+	case <-ctx.Done():
+		return nil, true, ctx.Err()
+	}
+}
+
+func (s *socketConn) write(msg string) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.ws.WriteMessage(websocket.TextMessage, []byte(msg))
+}
+
+// closed reports whether the connection is no longer usable.
+func (s *socketConn) closed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// close tears the connection down, failing all pending and future emits with err.
+func (s *socketConn) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return
+	}
+
+	s.err = err
+	close(s.done)
+	s.ws.Close()
+}
@@ -1,14 +1,24 @@
 package kuma
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 )
 
-func (c *Client) GetTags() ([]Tag, error) {
-	body, _, err := c.doRequest("GET", "/tags", nil)
+// GetTags returns all tags. The list is cached for the lifetime set by WithCacheTTL.
+func (c *Client) GetTags(ctx context.Context) ([]Tag, error) {
+	return cachedList(ctx, c, cacheKeyTags, c.fetchTags)
+}
+
+func (c *Client) fetchTags(ctx context.Context) ([]Tag, error) {
+	if c.socket != nil {
+		return c.socket.getTags(ctx)
+	}
+
+	body, _, err := c.doRequest(ctx, "GET", "/tags", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -27,30 +37,36 @@ func (c *Client) GetTags() ([]Tag, error) {
 	return tags.Tags, nil
 }
 
-func (c *Client) GetTag(tagName string) (*Tag, error) {
-	var t Tag
-
-	tags, err := c.GetTags()
+// GetTag looks up a tag by name. It returns an error matching ErrNotFound
+// when no tag with that name exists.
+func (c *Client) GetTag(ctx context.Context, tagName string) (*Tag, error) {
+	tags, err := c.GetTags(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, tag := range tags {
 		if tag.Name == tagName {
-			t = tag
-			break
+			return &tag, nil
 		}
 	}
-	return &t, nil
+
+	return nil, fmt.Errorf("tag %q: %w", tagName, ErrNotFound)
 }
 
-func (c *Client) CreateTag(tag Tag) (*Tag, error) {
+func (c *Client) CreateTag(ctx context.Context, tag Tag) (*Tag, error) {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyTags)
+		return c.socket.createTag(ctx, tag)
+	}
+
 	rb, err := json.Marshal(tag)
 	if err != nil {
 		return nil, err
 	}
 
-	body, _, err := c.doRequest("POST", "/tags", strings.NewReader(string(rb)))
+	body, _, err := c.doRequest(ctx, "POST", "/tags", strings.NewReader(string(rb)))
+	c.invalidateCache(cacheKeyTags)
 	if err != nil {
 		return nil, err
 	}
@@ -64,9 +80,15 @@ func (c *Client) CreateTag(tag Tag) (*Tag, error) {
 	return &newTag, nil
 }
 
-func (c *Client) DeleteTag(tagId int64) error {
+func (c *Client) DeleteTag(ctx context.Context, tagId int64) error {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyTags, cacheKeyMonitors)
+		return c.socket.deleteTag(ctx, tagId)
+	}
+
 	uri := fmt.Sprintf("/tags/%s", strconv.FormatInt(tagId, 10))
-	_, _, err := c.doRequest("DELETE", uri, nil)
+	_, _, err := c.doRequest(ctx, "DELETE", uri, nil)
+	c.invalidateCache(cacheKeyTags, cacheKeyMonitors)
 	if err != nil {
 		return err
 	}
@@ -74,13 +96,19 @@ func (c *Client) DeleteTag(tagId int64) error {
 	return nil
 }
 
-func (c *Client) UpdateTag(tagId int64, tagInfo Tag) error {
+func (c *Client) UpdateTag(ctx context.Context, tagId int64, tagInfo Tag) error {
+	if c.socket != nil {
+		defer c.invalidateCache(cacheKeyTags, cacheKeyMonitors)
+		return c.socket.updateTag(ctx, tagId, tagInfo)
+	}
+
 	rb, err := json.Marshal(tagInfo)
 	if err != nil {
 		return err
 	}
 
-	_, _, err = c.doRequest("PATCH", fmt.Sprintf("/tags/%s", strconv.FormatInt(tagId, 10)), strings.NewReader(string(rb)))
+	_, _, err = c.doRequest(ctx, "PATCH", fmt.Sprintf("/tags/%s", strconv.FormatInt(tagId, 10)), strings.NewReader(string(rb)))
+	c.invalidateCache(cacheKeyTags, cacheKeyMonitors)
 
 	return err
 }
@@ -0,0 +1,32 @@
+package kuma
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateTOTP(t *testing.T) {
+	// RFC 6238 appendix B test vectors for SHA1, truncated to 6 digits.
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	tests := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, want := range tests {
+		got, err := generateTOTP(secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("time %d: expected %s, got %s", unix, want, got)
+		}
+	}
+
+	if _, err := generateTOTP("not base32!", time.Now()); err == nil {
+		t.Error("expected an error for an invalid secret")
+	}
+}
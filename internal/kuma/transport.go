@@ -0,0 +1,118 @@
+package kuma
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const DefaultRequestTimeout = 300 * time.Second
+
+// TransportConfig describes how the client connects to the Kuma API.
+type TransportConfig struct {
+	// CACertPEM replaces the system roots when verifying the server certificate.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM enable mutual TLS.
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy taken from HTTP_PROXY/HTTPS_PROXY.
+	ProxyURL string
+	Timeout  time.Duration
+}
+
+// WithTransport configures TLS, proxy and timeout of the connection to Kuma.
+func WithTransport(cfg TransportConfig) ClientOption {
+	return func(c *Client) {
+		c.transport = &cfg
+	}
+}
+
+func (cfg TransportConfig) httpClient() (*http.Client, error) {
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		if transport.Proxy, err = cfg.proxy(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   cfg.timeout(),
+	}, nil
+}
+
+// websocketDialer returns a dialer for the Socket.IO backend sharing the TLS
+// and proxy settings of the HTTP client.
+func (cfg TransportConfig) websocketDialer() (*websocket.Dialer, error) {
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: websocket.DefaultDialer.HandshakeTimeout,
+	}
+
+	if cfg.ProxyURL != "" {
+		if dialer.Proxy, err = cfg.proxy(); err != nil {
+			return nil, err
+		}
+	}
+
+	return dialer, nil
+}
+
+func (cfg TransportConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, fmt.Errorf("no valid certificate found in CA certificate PEM")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (cfg TransportConfig) proxy() (func(*http.Request) (*url.URL, error), error) {
+	proxy, err := url.Parse(cfg.ProxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	return http.ProxyURL(proxy), nil
+}
+
+func (cfg TransportConfig) timeout() time.Duration {
+	if cfg.Timeout == 0 {
+		return DefaultRequestTimeout
+	}
+	return cfg.Timeout
+}
@@ -0,0 +1,53 @@
+package kuma
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTransportCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"tags": []}`))
+	}))
+	defer server.Close()
+
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	noRetry := WithRetry(0, 0, 0)
+
+	untrusted, err := NewClient(context.Background(), &server.URL, nil, nil, noRetry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := untrusted.GetTags(context.Background()); err == nil {
+		t.Error("expected a certificate error without the CA certificate")
+	}
+
+	trusted, err := NewClient(context.Background(), &server.URL, nil, nil, noRetry, WithTransport(TransportConfig{
+		CACertPEM: caCert,
+		Timeout:   5 * time.Second,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := trusted.GetTags(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	insecure, err := NewClient(context.Background(), &server.URL, nil, nil, noRetry, WithTransport(TransportConfig{
+		InsecureSkipVerify: true,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := insecure.GetTags(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewClient(context.Background(), &server.URL, nil, nil, WithTransport(TransportConfig{CACertPEM: "garbage"})); err == nil {
+		t.Error("expected an error for an invalid CA certificate")
+	}
+}
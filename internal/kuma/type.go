@@ -2,25 +2,56 @@ package kuma
 
 import (
 	"net/http"
+	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 type Client struct {
-	HostURL    string
-	HTTPClient *http.Client
-	Retry      int64
-	Interval   time.Duration
-	Token      string
-	Auth       AuthStruct
+	HostURL      string
+	HTTPClient   *http.Client
+	RetryMax     int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	Token        string
+	Auth         AuthStruct
+
+	transport *TransportConfig
+
+	// backend selects the API, socket is set for BackendSocketIO.
+	backend Backend
+	socket  *socketBackend
+
+	// inflight is a semaphore bounding concurrent requests, limiter bounds
+	// the request rate. Both are optional.
+	inflight chan struct{}
+	limiter  *rate.Limiter
+
+	cache *listCache
+
+	// info is the server info, fetched once, infoErr the error if that failed.
+	infoMu  sync.Mutex
+	info    *ServerInfo
+	infoErr error
+
+	// tokenMu guards Token, refreshMu serializes re-authentication.
+	tokenMu   sync.RWMutex
+	refreshMu sync.Mutex
 }
 
 type AuthStruct struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	// TOTPSecret is the base32 two-factor secret used to generate a code at
+	// every sign-in. TOTPToken is a fixed code used when no secret is set.
+	TOTPSecret string `json:"-"`
+	TOTPToken  string `json:"-"`
 }
 
 type AuthResponse struct {
-	Token string `json:"access_token"`
+	Token         string `json:"access_token"`
+	TokenRequired bool   `json:"tokenRequired"`
 }
 
 type Tag struct {
@@ -35,6 +66,27 @@ type MonitorTag struct {
 	Value string `json:"value,omitempty"`
 }
 
+// DockerHost is a Docker daemon that docker monitors check containers on.
+// DockerType is socket for a Unix socket or named pipe path in DockerDaemon,
+// and tcp for a tcp:// or https:// URL.
+type DockerHost struct {
+	ID           int64  `json:"id,omitempty"`
+	Name         string `json:"name"`
+	DockerDaemon string `json:"dockerDaemon"`
+	DockerType   string `json:"dockerType"`
+}
+
+// MonitorCondition is a check Uptime Kuma 2 applies to the result of a
+// monitor, such as a DNS record value. Conditions of type expression compare
+// Variable with Value, and are joined to the previous one by AndOr.
+type MonitorCondition struct {
+	Type     string `json:"type"`
+	Variable string `json:"variable"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+	AndOr    string `json:"andOr"`
+}
+
 type Monitor struct {
 	ID                                  int64        `json:"id,omitempty"`
 	Name                                string       `json:"name"`
@@ -61,20 +113,21 @@ type Monitor struct {
 	IgnoreTls                           bool         `json:"ignoreTls,omitempty"`
 	UpsideDown                          bool         `json:"upsideDown,omitempty"`
 	PacketSize                          int64        `json:"packetSize,omitempty"`
+	PingCount                           int64        `json:"ping_count,omitempty"`
+	PingPerRequestTimeout               int64        `json:"ping_per_request_timeout,omitempty"`
 	MaxRedirects                        int64        `json:"maxredirects,omitempty"`
 	AcceptedStatusCodes                 []string     `json:"accepted_statuscodes,omitempty"`
 	DNSResolveType                      string       `json:"dns_resolve_type,omitempty"`
 	DNSResolveServer                    string       `json:"dns_resolve_server,omitempty"`
 	DNSLastResult                       string       `json:"dns_last_result,omitempty"`
 	DockerContainer                     string       `json:"docker_container,omitempty"`
-	DockerHost                          string       `json:"docker_host,omitempty"`
+	DockerHost                          int64        `json:"docker_host,omitempty"`
 	ProxyID                             string       `json:"proxyId,omitempty"`
 	NotificationIDList                  []int64      `json:"notificationIDList,omitempty"`
 	Tags                                []MonitorTag `json:"tags,omitempty"`
 	Maintenance                         bool         `json:"maintenance,omitempty"`
 	MQTTTopic                           string       `json:"mqttTopic,omitempty"`
 	MQTTSuccessMessage                  string       `json:"mqttSuccessMessage,omitempty"`
-	DatabaseQuery                       string       `json:"databaseQuery,omitempty"`
 	AuthMethod                          string       `json:"authMethod,omitempty"`
 	GRPCURL                             string       `json:"grpcUrl,omitempty"`
 	GRPCProtobuf                        string       `json:"grpcProtobuf,omitempty"`
@@ -88,37 +141,47 @@ type Monitor struct {
 	HTTPBodyEncoding                    string       `json:"httpBodyEncoding,omitempty"`
 	JSONPath                            string       `json:"jsonPath,omitempty"`
 	ExpectedValue                       string       `json:"expectedValue,omitempty"`
+	JSONPathOperator                    string       `json:"jsonPathOperator,omitempty"`
 	KafkaProducerTopic                  string       `json:"kafkaProducerTopic,omitempty"`
 	KafkaProducerBrokers                string       `json:"kafkaProducerBrokers,omitempty"`
 	KafkaProducerSSL                    bool         `json:"kafkaProducerSsl,omitempty"`
 	KafkaProducerAllowAutoTopicCreation bool         `json:"kafkaProducerAllowAutoTopicCreation,omitempty"`
 	KafkaProducerMessage                string       `json:"kafkaProducerMessage,omitempty"`
 	Screenshot                          string       `json:"screenshot,omitempty"`
-	Headers                             string       `json:"headers,omitempty"`
+	Headers                             string       `json:"headers,omitempty" sensitive:"true"`
 	Body                                string       `json:"body,omitempty"`
 	GRPCBody                            string       `json:"grpcBody,omitempty"`
 	GRPCMetadata                        string       `json:"grpcMetadata,omitempty"`
 	BasicAuthUser                       string       `json:"basic_auth_user,omitempty"`
-	BasicAuthPass                       string       `json:"basic_auth_pass,omitempty"`
+	BasicAuthPass                       string       `json:"basic_auth_pass,omitempty" sensitive:"true"`
 	OAuthClientID                       string       `json:"oauth_client_id,omitempty"`
-	OAuthClientSecret                   string       `json:"oauth_client_secret,omitempty"`
+	OAuthClientSecret                   string       `json:"oauth_client_secret,omitempty" sensitive:"true"`
 	OAuthTokenURL                       string       `json:"oauth_token_url,omitempty"`
 	OAuthScopes                         string       `json:"oauth_scopes,omitempty"`
 	OAuthAuthMethod                     string       `json:"oauth_auth_method,omitempty"`
-	PushToken                           string       `json:"pushToken,omitempty"`
-	DatabaseConnectionString            string       `json:"databaseConnectionString,omitempty"`
+	PushToken                           string       `json:"pushToken,omitempty" sensitive:"true"`
+	DatabaseConnectionString            string       `json:"databaseConnectionString,omitempty" sensitive:"true"`
 	RADIUSUsername                      string       `json:"radiusUsername,omitempty"`
-	RADIUSPassword                      string       `json:"radiusPassword,omitempty"`
-	RADIUSSecret                        string       `json:"radiusSecret,omitempty"`
+	RADIUSPassword                      string       `json:"radiusPassword,omitempty" sensitive:"true"`
+	RADIUSSecret                        string       `json:"radiusSecret,omitempty" sensitive:"true"`
 	MQTTUsername                        string       `json:"mqttUsername,omitempty"`
-	MQTTPassword                        string       `json:"mqttPassword,omitempty"`
+	MQTTPassword                        string       `json:"mqttPassword,omitempty" sensitive:"true"`
 	AuthWorkstation                     string       `json:"authWorkstation,omitempty"`
 	AuthDomain                          string       `json:"authDomain,omitempty"`
 	TLSCA                               string       `json:"tlsCa,omitempty"`
 	TLSCert                             string       `json:"tlsCert,omitempty"`
-	TLSKey                              string       `json:"tlsKey,omitempty"`
-	KafkaProducerSaslOptions            string       `json:"kafkaProducerSaslOptions,omitempty"`
+	TLSKey                              string       `json:"tlsKey,omitempty" sensitive:"true"`
+	KafkaProducerSaslOptions            string       `json:"kafkaProducerSaslOptions,omitempty" sensitive:"true"`
 	IncludeSensitiveData                bool         `json:"includeSensitiveData,omitempty"`
+
+	// Conditions is only known to Uptime Kuma 2. Nil leaves the conditions
+	// unchanged, an empty list removes them.
+	Conditions *[]MonitorCondition `json:"conditions,omitempty"`
+
+	// DatabaseQuery is a pointer so that an update can clear it. Nil leaves
+	// the query unchanged, or unset on a new monitor, an empty string clears
+	// it.
+	DatabaseQuery *string `json:"databaseQuery,omitempty"`
 }
 
 type Notification struct {
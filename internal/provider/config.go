@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// defaultConfigFile returns the location of the shared config file used when
+// neither config_file nor KUMA_CONFIG_FILE is set.
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".config", "kuma", "config.yaml")
+}
+
+// loadConfigFile reads connection settings from a YAML config file. Settings
+// are taken from the top level of the file, or from the named entry under
+// `profiles:` when profile is set:
+//
+//	host: http://localhost:8000
+//	username: admin
+//	password: admin
+//	profiles:
+//	  prod:
+//	    host: https://kuma.example.com
+//	    token: ...
+//	    backend: socketio
+//
+// A missing file is only an error when it was explicitly requested.
+func loadConfigFile(path, profile string) (*KumaConfiguration, error) {
+	var c KumaConfiguration
+
+	explicit := path != ""
+	if !explicit {
+		path = defaultConfigFile()
+	}
+
+	if path == "" {
+		return &c, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			if profile != "" {
+				return nil, fmt.Errorf("profile %q requested but config file %s does not exist", profile, path)
+			}
+			return &c, nil
+		}
+		return nil, fmt.Errorf("read config file %s: %w", path, err)
+	}
+
+	if profile != "" {
+		v = v.Sub("profiles." + profile)
+		if v == nil {
+			return nil, fmt.Errorf("profile %q not found in config file %s", profile, path)
+		}
+	}
+
+	if err := v.Unmarshal(&c); err != nil {
+		return nil, fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	return &c, nil
+}
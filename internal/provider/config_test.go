@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+
+	content := `host: http://localhost:8000
+username: admin
+password: admin
+profiles:
+  prod:
+    host: https://kuma.example.com
+    token: secret-token
+    backend: socketio
+  2fa:
+    host: https://kuma.internal
+    username: ops
+    password: ops
+    totp_secret: JBSWY3DPEHPK3PXP
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := loadConfigFile(file, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Host != "http://localhost:8000" || c.Username != "admin" || c.Password != "admin" {
+		t.Errorf("unexpected top-level settings: %+v", c)
+	}
+
+	c, err = loadConfigFile(file, "prod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Host != "https://kuma.example.com" || c.Token != "secret-token" || c.Backend != "socketio" || c.Username != "" {
+		t.Errorf("unexpected prod profile settings: %+v", c)
+	}
+
+	c, err = loadConfigFile(file, "2fa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TOTPSecret != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("unexpected 2fa profile settings: %+v", c)
+	}
+
+	if _, err := loadConfigFile(file, "missing"); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+}
+
+func TestLoadConfigFileMissing(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// The default config file is optional.
+	c, err := loadConfigFile("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *c != (KumaConfiguration{}) {
+		t.Errorf("expected empty settings, got %+v", c)
+	}
+
+	if _, err := loadConfigFile("", "prod"); err == nil {
+		t.Error("expected an error for a profile without config file")
+	}
+
+	if _, err := loadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"), ""); err == nil {
+		t.Error("expected an error for an explicitly set config file that does not exist")
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatabaseMonitorResourceModel is the model of the database monitors that
+// only connect, such as Redis.
+type DatabaseMonitorResourceModel struct {
+	MonitorBaseModel
+
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+func (m *DatabaseMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.MonitorBaseModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.DatabaseConnectionString = m.ConnectionString.ValueString()
+
+	return monitor, nil
+}
+
+func (m *DatabaseMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.ConnectionString = types.StringValue(stu.DatabaseConnectionString)
+
+	return m.MonitorBaseModel.ConvertFrom(stu)
+}
+
+// DatabaseQueryMonitorResourceModel is the model of the database monitors
+// that can run a query.
+type DatabaseQueryMonitorResourceModel struct {
+	DatabaseMonitorResourceModel
+
+	Query types.String `tfsdk:"query"`
+}
+
+func (m *DatabaseQueryMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.DatabaseMonitorResourceModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	// A new monitor only gets a query when one is set. On an existing
+	// monitor, whose ID is known, an empty query clears the one set before.
+	if !m.Query.IsNull() || !m.ID.IsUnknown() {
+		query := m.Query.ValueString()
+		monitor.DatabaseQuery = &query
+	}
+
+	return monitor, nil
+}
+
+func (m *DatabaseQueryMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.Query = types.StringNull()
+	if stu.DatabaseQuery != nil && *stu.DatabaseQuery != "" {
+		m.Query = types.StringValue(*stu.DatabaseQuery)
+	}
+
+	return m.DatabaseMonitorResourceModel.ConvertFrom(stu)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DnsMonitorResourceModel struct {
+	MonitorBaseModel
+
+	Hostname       types.String `tfsdk:"hostname"`
+	ResolverServer types.String `tfsdk:"resolver_server"`
+	Port           types.Int64  `tfsdk:"port"`
+	RecordType     types.String `tfsdk:"record_type"`
+	LastResult     types.String `tfsdk:"last_result"`
+	Conditions     types.List   `tfsdk:"conditions"`
+}
+
+type DnsConditionModel struct {
+	Variable types.String `tfsdk:"variable"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	AndOr    types.String `tfsdk:"and_or"`
+}
+
+var dnsConditionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"variable": types.StringType,
+		"operator": types.StringType,
+		"value":    types.StringType,
+		"and_or":   types.StringType,
+	},
+}
+
+func (m *DnsMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.MonitorBaseModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.Hostname = m.Hostname.ValueString()
+	monitor.DNSResolveServer = m.ResolverServer.ValueString()
+	monitor.Port = m.Port.ValueInt64()
+	monitor.DNSResolveType = m.RecordType.ValueString()
+
+	// Unset conditions are left alone, servers before 2.0 do not know them.
+	if !m.Conditions.IsNull() && !m.Conditions.IsUnknown() {
+		var conditions []DnsConditionModel
+
+		err = m.Conditions.ElementsAs(context.Background(), &conditions, false)
+		if err.HasError() {
+			return nil, err
+		}
+
+		newConditions := make([]kuma.MonitorCondition, 0, len(conditions))
+		for _, condition := range conditions {
+			newConditions = append(newConditions, kuma.MonitorCondition{
+				Type:     "expression",
+				Variable: condition.Variable.ValueString(),
+				Operator: condition.Operator.ValueString(),
+				Value:    condition.Value.ValueString(),
+				AndOr:    condition.AndOr.ValueString(),
+			})
+		}
+		monitor.Conditions = &newConditions
+	}
+
+	return monitor, nil
+}
+
+func (m *DnsMonitorResourceModel) ConvertFrom(stu kuma.Monitor) (err diag.Diagnostics) {
+	m.Hostname = types.StringValue(stu.Hostname)
+	m.ResolverServer = types.StringValue(stu.DNSResolveServer)
+	m.Port = types.Int64Value(stu.Port)
+	m.RecordType = types.StringValue(stu.DNSResolveType)
+	m.LastResult = types.StringValue(stu.DNSLastResult)
+
+	conditions := make([]DnsConditionModel, 0)
+	if stu.Conditions != nil {
+		for _, condition := range *stu.Conditions {
+			// Groups of conditions can only be managed in the UI. Dropping
+			// them here would delete them on the next update without the
+			// plan showing it.
+			if condition.Type != "expression" {
+				err.AddError(
+					"Unsupported DNS Monitor Condition",
+					fmt.Sprintf("Monitor %s, ID: %d has a condition of type %q, only expressions are supported. "+
+						"Replace it with expressions in the Uptime Kuma UI to manage the monitor with Terraform.", stu.Name, stu.ID, condition.Type),
+				)
+				return err
+			}
+			conditions = append(conditions, DnsConditionModel{
+				Variable: types.StringValue(condition.Variable),
+				Operator: types.StringValue(condition.Operator),
+				Value:    types.StringValue(condition.Value),
+				AndOr:    types.StringValue(condition.AndOr),
+			})
+		}
+	}
+
+	m.Conditions, err = types.ListValueFrom(context.Background(), dnsConditionType, conditions)
+	if err.HasError() {
+		return err
+	}
+
+	return m.MonitorBaseModel.ConvertFrom(stu)
+}
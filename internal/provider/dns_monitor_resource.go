@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &dnsMonitorResource{}
+var _ resource.ResourceWithImportState = &dnsMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &dnsMonitorResource{}
+
+func NewDnsMonitorResource() resource.Resource {
+	return &dnsMonitorResource{
+		monitorResource: monitorResource[DnsMonitorResourceModel, *DnsMonitorResourceModel]{
+			requirements: []serverRequirement{
+				{Path: path.Root("conditions"), MinVersion: "2.0.0", Feature: "The conditions attribute"},
+			},
+		},
+	}
+}
+
+// dnsMonitorResource manages monitors that resolve a DNS record.
+type dnsMonitorResource struct {
+	monitorResource[DnsMonitorResourceModel, *DnsMonitorResourceModel]
+}
+
+func (r *dnsMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_monitor"
+}
+
+func (r *dnsMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a monitor that is up when a DNS record resolves.",
+
+		Attributes: monitorAttributes("dns", map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name to resolve.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resolver_server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "DNS server to query. defaults to `1.1.1.1`.",
+				Default:             stringdefault.StaticString("1.1.1.1"),
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Port of the DNS server. defaults to `53`.",
+				Default:             int64default.StaticInt64(53),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"record_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Type of the record to resolve, one of `A`, `AAAA`, `CAA`, `CNAME`, `MX`, `NS`, `PTR`, `SOA`, `SRV` and `TXT`. defaults to `A`.",
+				Default:             stringdefault.StaticString("A"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"),
+				},
+			},
+			"last_result": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Records returned by the last check.",
+			},
+			"conditions": schema.ListNestedAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Conditions the resolved records must meet, evaluated in order. " +
+					"Removing the attribute leaves the conditions on the server, set it to `[]` to remove them. " +
+					"Groups of conditions created in the UI are not supported, reading a monitor that has some fails. Requires Uptime Kuma 2.0.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"variable": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Value the condition checks. defaults to `record`.",
+							Default:             stringdefault.StaticString("record"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("record"),
+							},
+						},
+						"operator": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "One of `equals`, `not_equals`, `contains`, `not_contains`, `starts_with`, `not_starts_with`, `ends_with` and `not_ends_with`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("equals", "not_equals", "contains", "not_contains", "starts_with", "not_starts_with", "ends_with", "not_ends_with"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Value compared with the variable.",
+						},
+						"and_or": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "How the condition joins the previous one, `and` or `or`. defaults to `and`.",
+							Default:             stringdefault.StaticString("and"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("and", "or"),
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.Version = "2.0.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_dns_monitor" "test" {
+  name        = "mail"
+  hostname    = "example.com"
+  record_type = "MXX"
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute record_type value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_dns_monitor" "test" {
+  name        = "mail"
+  hostname    = "example.com"
+  record_type = "MX"
+
+  conditions = [
+    {
+      operator = "contains"
+      value    = "mx1.example.com"
+    },
+    {
+      operator = "contains"
+      value    = "mx2.example.com"
+      and_or   = "or"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "type", "dns"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "resolver_server", "1.1.1.1"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "port", "53"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "record_type", "MX"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "last_result", ""),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.#", "2"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.0.variable", "record"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.0.and_or", "and"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.1.and_or", "or"),
+					testAccCheckMonitor(server, "kuma_dns_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.DNSResolveType != "MX" || monitor.Conditions == nil || len(*monitor.Conditions) != 2 {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						if condition := (*monitor.Conditions)[1]; condition.Type != "expression" || condition.Value != "mx2.example.com" {
+							return fmt.Errorf("unexpected condition: %+v", condition)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_dns_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The result of a check shows up on refresh.
+			{
+				PreConfig: func() {
+					for _, monitor := range server.Monitors() {
+						server.UpdateMonitor(monitor.ID, func(m *kuma.Monitor) {
+							m.DNSLastResult = "Hostname: mx1.example.com | Priority: 10"
+						})
+					}
+				},
+				RefreshState: true,
+				Check:        resource.TestCheckResourceAttr("kuma_dns_monitor.test", "last_result", "Hostname: mx1.example.com | Priority: 10"),
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_dns_monitor" "test" {
+  name            = "mail"
+  hostname        = "example.com"
+  record_type     = "MX"
+  resolver_server = "9.9.9.9"
+  conditions      = []
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_dns_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "resolver_server", "9.9.9.9"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.#", "0"),
+					testAccCheckMonitor(server, "kuma_dns_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Conditions == nil || len(*monitor.Conditions) != 0 {
+							return fmt.Errorf("expected the conditions to be removed, got %+v", monitor.Conditions)
+						}
+						return nil
+					}),
+				),
+			},
+			// Groups of conditions made in the UI are not silently dropped.
+			{
+				PreConfig: func() {
+					for _, monitor := range server.Monitors() {
+						server.UpdateMonitor(monitor.ID, func(m *kuma.Monitor) {
+							m.Conditions = &[]kuma.MonitorCondition{{Type: "group", AndOr: "and"}}
+						})
+					}
+				},
+				RefreshState: true,
+				ExpectError:  regexp.MustCompile(`has a condition of type "group", only expressions are\s+supported`),
+			},
+			{
+				PreConfig: func() {
+					for _, monitor := range server.Monitors() {
+						server.UpdateMonitor(monitor.ID, func(m *kuma.Monitor) {
+							m.Conditions = &[]kuma.MonitorCondition{}
+						})
+					}
+				},
+				RefreshState: true,
+				Check:        resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.#", "0"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsMonitorResourceBeforeConditions(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_dns_monitor" "test" {
+  name     = "www"
+  hostname = "www.example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "record_type", "A"),
+					resource.TestCheckResourceAttr("kuma_dns_monitor.test", "conditions.#", "0"),
+					// Servers before 2.0 must not receive conditions at all.
+					func(s *terraform.State) error {
+						for _, monitor := range server.Monitors() {
+							if monitor.Conditions != nil {
+								return fmt.Errorf("unexpected conditions sent: %+v", *monitor.Conditions)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_dns_monitor" "test" {
+  name     = "www"
+  hostname = "www.example.com"
+
+  conditions = [{ operator = "equals", value = "93.184.215.14" }]
+}
+`,
+				ExpectError: regexp.MustCompile(`The conditions attribute requires Uptime Kuma 2\.0\.0 or later`),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DockerContainerMonitorResourceModel struct {
+	MonitorBaseModel
+
+	DockerContainer types.String `tfsdk:"docker_container"`
+	DockerHost      types.Int64  `tfsdk:"docker_host"`
+}
+
+func (m *DockerContainerMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.MonitorBaseModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.DockerContainer = m.DockerContainer.ValueString()
+	monitor.DockerHost = m.DockerHost.ValueInt64()
+
+	return monitor, nil
+}
+
+func (m *DockerContainerMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.DockerContainer = types.StringValue(stu.DockerContainer)
+	m.DockerHost = types.Int64Value(stu.DockerHost)
+
+	return m.MonitorBaseModel.ConvertFrom(stu)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &dockerContainerMonitorResource{}
+var _ resource.ResourceWithImportState = &dockerContainerMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &dockerContainerMonitorResource{}
+
+func NewDockerContainerMonitorResource() resource.Resource {
+	return &dockerContainerMonitorResource{}
+}
+
+// dockerContainerMonitorResource manages monitors that check a container is
+// running on a docker host.
+type dockerContainerMonitorResource struct {
+	monitorResource[DockerContainerMonitorResourceModel, *DockerContainerMonitorResourceModel]
+}
+
+func (r *dockerContainerMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docker_container_monitor"
+}
+
+func (r *dockerContainerMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a monitor that is up while a Docker container is running.",
+
+		Attributes: monitorAttributes("docker", map[string]schema.Attribute{
+			"docker_container": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name or ID of the container.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"docker_host": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the `kuma_docker_host` the container runs on.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		}),
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDockerContainerMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.AddTag(kuma.Tag{Name: "env", Color: "#2563EB"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "local" {
+  name          = "local"
+  docker_daemon = "/var/run/docker.sock"
+}
+
+resource "kuma_docker_container_monitor" "test" {
+  name             = "nginx"
+  docker_container = "nginx"
+  docker_host      = kuma_docker_host.local.id
+
+  tags = {
+    env = "prod"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_docker_container_monitor.test", "type", "docker"),
+					resource.TestCheckResourceAttr("kuma_docker_container_monitor.test", "docker_container", "nginx"),
+					resource.TestCheckResourceAttrPair("kuma_docker_container_monitor.test", "docker_host", "kuma_docker_host.local", "id"),
+					resource.TestCheckResourceAttr("kuma_docker_container_monitor.test", "tags.env", "prod"),
+					testAccCheckMonitor(server, "kuma_docker_container_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Type != "docker" || monitor.DockerContainer != "nginx" || monitor.DockerHost == 0 {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_docker_container_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place, moving the monitor to another host.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "local" {
+  name          = "local"
+  docker_daemon = "/var/run/docker.sock"
+}
+
+resource "kuma_docker_host" "remote" {
+  name          = "remote"
+  docker_daemon = "tcp://docker.internal:2375"
+}
+
+resource "kuma_docker_container_monitor" "test" {
+  name             = "nginx"
+  docker_container = "nginx-1"
+  docker_host      = kuma_docker_host.remote.id
+
+  tags = {
+    env = "prod"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_docker_container_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_docker_container_monitor.test", "docker_container", "nginx-1"),
+					resource.TestCheckResourceAttrPair("kuma_docker_container_monitor.test", "docker_host", "kuma_docker_host.remote", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"strings"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DockerHost struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	DockerDaemon types.String `tfsdk:"docker_daemon"`
+	DockerType   types.String `tfsdk:"docker_type"`
+}
+
+func (h *DockerHost) Convert() *kuma.DockerHost {
+	dockerType := h.DockerType.ValueString()
+	if h.DockerType.IsNull() || h.DockerType.IsUnknown() {
+		dockerType = dockerTypeOf(h.DockerDaemon.ValueString())
+	}
+
+	return &kuma.DockerHost{
+		ID:           h.ID.ValueInt64(),
+		Name:         h.Name.ValueString(),
+		DockerDaemon: h.DockerDaemon.ValueString(),
+		DockerType:   dockerType,
+	}
+}
+
+func (h *DockerHost) ConvertFrom(host kuma.DockerHost) {
+	h.ID = types.Int64Value(host.ID)
+	h.Name = types.StringValue(host.Name)
+	h.DockerDaemon = types.StringValue(host.DockerDaemon)
+	h.DockerType = types.StringValue(host.DockerType)
+}
+
+// dockerTypeOf returns the connection type Kuma uses for a daemon address:
+// URLs are reached over TCP, anything else is a socket path.
+func dockerTypeOf(daemon string) string {
+	if strings.Contains(daemon, "://") {
+		return "tcp"
+	}
+	return "socket"
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dockerHostResource{}
+	_ resource.ResourceWithConfigure      = &dockerHostResource{}
+	_ resource.ResourceWithImportState    = &dockerHostResource{}
+	_ resource.ResourceWithModifyPlan     = &dockerHostResource{}
+	_ resource.ResourceWithValidateConfig = &dockerHostResource{}
+)
+
+// dockerDaemonPattern matches a socket or named pipe path, or the URL of a
+// daemon listening on TCP.
+var dockerDaemonPattern = regexp.MustCompile(`^(/|\\\\\.\\pipe\\|(tcp|https?)://).+`)
+
+func NewDockerHostResource() resource.Resource {
+	return &dockerHostResource{}
+}
+
+type dockerHostResource struct {
+	client kuma.API
+}
+
+// Metadata returns the resource type name.
+func (r *dockerHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docker_host"
+}
+
+// Schema defines the schema for the resource.
+func (r *dockerHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a Docker daemon that `kuma_docker_container_monitor` resources check containers on.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Display name of the docker host.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"docker_daemon": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Socket path, for example `/var/run/docker.sock`, or URL of the daemon, for example `tcp://docker:2375`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dockerDaemonPattern, "must be a socket path or a tcp://, http:// or https:// URL"),
+				},
+			},
+			"docker_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Connection type, `socket` or `tcp`. Defaults to `tcp` when `docker_daemon` is a URL and to `socket` otherwise.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("socket", "tcp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan plans the docker_type inferred from a new daemon address, the
+// one kept from state only fits the previous address.
+func (r *dockerHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan DockerHost
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !config.DockerType.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state DockerHost
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.DockerDaemon.Equal(plan.DockerDaemon) {
+			return
+		}
+	}
+
+	dockerType := types.StringUnknown()
+	if !plan.DockerDaemon.IsUnknown() {
+		dockerType = types.StringValue(dockerTypeOf(plan.DockerDaemon.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("docker_type"), dockerType)...)
+}
+
+// ValidateConfig rejects a docker_type that does not fit the daemon address.
+func (r *dockerHostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DockerHost
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DockerType.IsNull() || config.DockerType.IsUnknown() || config.DockerDaemon.IsUnknown() {
+		return
+	}
+
+	if want := dockerTypeOf(config.DockerDaemon.ValueString()); config.DockerType.ValueString() != want {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("docker_type"),
+			"Invalid Docker Type",
+			fmt.Sprintf("The docker daemon %q needs docker_type %q.", config.DockerDaemon.ValueString(), want),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dockerHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DockerHost
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, err := r.client.CreateDockerHost(ctx, *plan.Convert())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Kuma Docker Host",
+			"Could not create docker host, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ConvertFrom(*host)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dockerHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DockerHost
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, err := r.client.GetDockerHost(ctx, state.ID.ValueInt64())
+	if removeIfNotFound(ctx, err, resp) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kuma Docker Host",
+			fmt.Sprintf("Could not read Kuma Docker Host %s, ID: %d %s", state.Name.ValueString(), state.ID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	state.ConvertFrom(*host)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dockerHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DockerHost
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateDockerHost(ctx, plan.ID.ValueInt64(), *plan.Convert()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Kuma Docker Host",
+			fmt.Sprintf("Could not update Kuma Docker Host %s, ID: %d %s", plan.Name.ValueString(), plan.ID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	host, err := r.client.GetDockerHost(ctx, plan.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kuma Docker Host",
+			fmt.Sprintf("Could not read Kuma Docker Host %s, ID: %d %s", plan.Name.ValueString(), plan.ID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	plan.ConvertFrom(*host)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dockerHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DockerHost
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteDockerHost(ctx, state.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Kuma Docker Host",
+			"Could not delete docker host, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dockerHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(kuma.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dockerHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the numeric ID of the docker host, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
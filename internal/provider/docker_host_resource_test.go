@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccDockerHostResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if hosts := server.DockerHosts(); len(hosts) > 0 {
+				return fmt.Errorf("expected all docker hosts to be destroyed, found %d", len(hosts))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "test" {
+  name          = "local"
+  docker_daemon = "docker.sock"
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a socket path or a tcp://, http://\s+or\s+https://\s+URL`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "test" {
+  name          = "local"
+  docker_daemon = "/var/run/docker.sock"
+  docker_type   = "tcp"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Docker Type`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "test" {
+  name          = "local"
+  docker_daemon = "/var/run/docker.sock"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_docker_host.test", "name", "local"),
+					resource.TestCheckResourceAttr("kuma_docker_host.test", "docker_daemon", "/var/run/docker.sock"),
+					resource.TestCheckResourceAttr("kuma_docker_host.test", "docker_type", "socket"),
+					resource.TestCheckResourceAttrSet("kuma_docker_host.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_docker_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Renaming keeps the inferred docker type.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "test" {
+  name          = "docker"
+  docker_daemon = "/var/run/docker.sock"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_docker_host.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("kuma_docker_host.test", tfjsonpath.New("docker_type"), knownvalue.StringExact("socket")),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("kuma_docker_host.test", "name", "docker"),
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "test" {
+  name          = "remote"
+  docker_daemon = "tcp://docker.internal:2375"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_docker_host.test", plancheck.ResourceActionUpdate),
+						// A new daemon address gets the type that fits it.
+						plancheck.ExpectKnownValue("kuma_docker_host.test", tfjsonpath.New("docker_type"), knownvalue.StringExact("tcp")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_docker_host.test", "name", "remote"),
+					resource.TestCheckResourceAttr("kuma_docker_host.test", "docker_type", "tcp"),
+				),
+			},
+			// Drift: the host is deleted in the UI and gets recreated.
+			{
+				PreConfig: func() {
+					for _, host := range server.DockerHosts() {
+						server.DeleteDockerHost(host.ID)
+					}
+				},
+				Config: testAccProviderConfig(server) + `
+resource "kuma_docker_host" "test" {
+  name          = "remote"
+  docker_daemon = "tcp://docker.internal:2375"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_docker_host.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("kuma_docker_host.test", "name", "remote"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -20,15 +20,21 @@ var (
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupResource{}
 )
 
+// groupRequirements are the server versions the group resource needs.
+var groupRequirements = []serverRequirement{
+	{MinVersion: "1.21.0", Feature: "The kuma_group resource"},
+}
+
 // NewGroupResource is a helper function to simplify the provider implementation.
 func NewGroupResource() resource.Resource {
 	return &groupResource{}
 }
 
 type groupResource struct {
-	client *kuma.Client
+	client kuma.API
 }
 
 // Metadata returns the resource type name.
@@ -67,26 +73,27 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// ModifyPlan rejects groups on servers that predate them.
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkServerRequirements(ctx, r.client, req, resp, groupRequirements)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan groupModel
 
-	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(diags...)
 		return
 	}
 
 	item, diags := plan.Convert(ctx)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	tflog.Debug(ctx, "[INPUT_PLAN]"+fmt.Sprintf("%+v", plan))
-	tflog.Debug(ctx, "[INPUT_ITEM]"+fmt.Sprintf("%+v", item))
-
-	monitorID, err := r.client.CreateMonitor(*item)
+	monitorID, err := r.client.CreateMonitor(ctx, *item)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating monitor",
@@ -95,29 +102,35 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Record the monitor right away so that a failure below taints it
+	// instead of leaving it on the server untracked.
+	diags = resp.State.SetAttribute(ctx, path.Root("id"), *monitorID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Monitor create done")
 
 	for _, tag := range item.Tags {
-		if err = r.client.CreateMonitorTag(*monitorID, tag); err != nil {
+		if err = r.client.CreateMonitorTag(ctx, *monitorID, tag); err != nil {
 			resp.Diagnostics.AddError(
-				"Error Ceating Kuma Monitor Tag",
-				fmt.Sprintf("Could not ceate Kuma Monitor tag %s, tags: %+v %s", plan.Name.ValueString(), tag, err.Error()),
+				"Error Creating Kuma Monitor Tag",
+				fmt.Sprintf("Could not create Kuma Monitor tag %s, tags: %+v %s", plan.Name.ValueString(), tag, err.Error()),
 			)
 			return
 		}
 	}
 
-	monitor, err := r.client.GetMonitor(*monitorID)
+	monitor, err := r.client.GetMonitor(ctx, *monitorID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Reading Kuma Tag",
-			"Could not read Kuma Tag",
+			"Error Reading Kuma Monitor",
+			fmt.Sprintf("Could not read Kuma Monitor %s, ID: %d %s", plan.Name.ValueString(), *monitorID, err.Error()),
 		)
 		return
 	}
 
-	tflog.Debug(ctx, "[OUTPUT_ITEM]"+fmt.Sprintf("%+v", monitor))
-
 	diags = plan.ConvertFrom(ctx, monitor)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -142,11 +155,14 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	monitor, err := r.client.GetMonitor(state.ID.ValueInt64())
+	monitor, err := r.client.GetMonitor(ctx, state.ID.ValueInt64())
+	if removeIfNotFound(ctx, err, resp) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Reading Kuma Tag",
-			fmt.Sprintf("Could not read Kuma Tag %s, ID: %d %s", state.Name.ValueString(), int(state.ID.ValueInt64()), err.Error()),
+			"Error Reading Kuma Monitor",
+			fmt.Sprintf("Could not read Kuma Monitor %s, ID: %d %s", state.Name.ValueString(), int(state.ID.ValueInt64()), err.Error()),
 		)
 		return
 	}
@@ -182,7 +198,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update existing tag
-	if err := r.client.UpdateMonitor(plan.ID.ValueInt64(), *item); err != nil {
+	if err := r.client.UpdateMonitor(ctx, plan.ID.ValueInt64(), *item); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Kuma Monitor",
 			fmt.Sprintf("Could not update Kuma Monitor %s, ID: %d %s", plan.Name.ValueString(), int(plan.ID.ValueInt64()), err.Error()),
@@ -191,7 +207,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Fetch updated tag
-	monitor, err := r.client.GetMonitor(plan.ID.ValueInt64())
+	monitor, err := r.client.GetMonitor(ctx, plan.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Kuma Monitor",
@@ -213,7 +229,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	for name, tag := range curTag {
 		// check current tag isn't in plan tag
 		if _, ok := planTag[name]; !ok {
-			if err := r.client.DeleteMonitorTag(plan.ID.ValueInt64(), tag); err != nil {
+			if err := r.client.DeleteMonitorTag(ctx, plan.ID.ValueInt64(), tag); err != nil {
 				resp.Diagnostics.AddError(
 					"Error Updating Kuma Tag",
 					fmt.Sprintf("Could not Delete Kuma Tag %s, ID: %d %s", name, tag.TagId, err.Error()),
@@ -225,7 +241,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 			delete(planTag, name)
 		} else if ok && planTag[name].Value != tag.Value {
 			// update changed tag
-			if err := r.client.DeleteMonitorTag(plan.ID.ValueInt64(), tag); err != nil {
+			if err := r.client.DeleteMonitorTag(ctx, plan.ID.ValueInt64(), tag); err != nil {
 				resp.Diagnostics.AddError(
 					"Error Updating Kuma Tag",
 					fmt.Sprintf("Could not Delete Kuma Tag %s, ID: %d %s", name, tag.TagId, err.Error()),
@@ -236,7 +252,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	for tag := range planTag {
-		if err := r.client.CreateMonitorTag(plan.ID.ValueInt64(), planTag[tag]); err != nil {
+		if err := r.client.CreateMonitorTag(ctx, plan.ID.ValueInt64(), planTag[tag]); err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating Kuma Tag",
 				fmt.Sprintf("Could not create Kuma Tag %s, ID: %d %s", tag, planTag[tag].TagId, err.Error()),
@@ -245,7 +261,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	monitor, err = r.client.GetMonitor(plan.ID.ValueInt64())
+	monitor, err = r.client.GetMonitor(ctx, plan.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Kuma Monitor",
@@ -278,7 +294,7 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete existing order
-	err := r.client.DeleteMonitor(state.ID.ValueInt64())
+	err := r.client.DeleteMonitor(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Kuma Monitor",
@@ -293,12 +309,12 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(*kuma.Client)
+	client, ok := req.ProviderData.(kuma.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -308,6 +324,5 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	importMonitorState(ctx, req, resp)
 }
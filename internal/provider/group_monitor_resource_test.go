@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumamock"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.AddTag(kuma.Tag{Name: "env", Color: "#2563EB"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_group" "test" {
+  name        = "backend"
+  description = "backend services"
+  tags = {
+    env = "prod"
+  }
+}
+
+resource "kuma_http_monitor" "child" {
+  name   = "api"
+  url    = "https://api.example.com"
+  parent = kuma_group.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_group.test", "name", "backend"),
+					resource.TestCheckResourceAttr("kuma_group.test", "type", "group"),
+					resource.TestCheckResourceAttr("kuma_group.test", "description", "backend services"),
+					resource.TestCheckResourceAttr("kuma_group.test", "tags.env", "prod"),
+					resource.TestCheckResourceAttrPair("kuma_http_monitor.child", "parent", "kuma_group.test", "id"),
+					testAccCheckMonitorExists(server, "kuma_group.test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_group" "test" {
+  name        = "backend-services"
+  description = "all backend services"
+}
+
+resource "kuma_http_monitor" "child" {
+  name   = "api"
+  url    = "https://api.example.com"
+  parent = kuma_group.test.id
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_group.test", "name", "backend-services"),
+					resource.TestCheckResourceAttr("kuma_group.test", "description", "all backend services"),
+					resource.TestCheckNoResourceAttr("kuma_group.test", "tags.env"),
+				),
+			},
+			// Drift: the group is deleted in the UI and gets recreated.
+			{
+				PreConfig: func() {
+					for _, monitor := range server.Monitors() {
+						if monitor.Type == "group" {
+							server.DeleteMonitor(monitor.ID)
+						}
+					}
+				},
+				Config: testAccProviderConfig(server) + `
+resource "kuma_group" "test" {
+  name        = "backend-services"
+  description = "all backend services"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_group.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: testAccCheckMonitorExists(server, "kuma_group.test"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupResourceUnsupportedServer(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.Version = "1.20.2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_group" "test" {
+  name = "backend"
+}
+`,
+				ExpectError: regexp.MustCompile(`The kuma_group resource requires Uptime Kuma 1\.21\.0 or later, the server runs\s+1\.20\.2`),
+			},
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_http_monitor" "test" {
+  name   = "api"
+  url    = "https://api.example.com"
+  parent = 1
+}
+`,
+				ExpectError: regexp.MustCompile(`The parent attribute requires Uptime Kuma 1\.21\.0 or later`),
+			},
+		},
+	})
+}
+
+func TestGroupResourceCreateReadFailure(t *testing.T) {
+	client := kumamock.NewMockAPI(gomock.NewController(t))
+	monitorID := int64(7)
+
+	client.EXPECT().CreateMonitor(gomock.Any(), gomock.Any()).Return(&monitorID, nil)
+	client.EXPECT().CreateMonitorTag(gomock.Any(), monitorID, kuma.MonitorTag{Name: "env", Value: "prod"}).Return(nil)
+	client.EXPECT().GetMonitor(gomock.Any(), monitorID).Return(nil, errors.New("connection reset"))
+
+	resp := testCreate(t, &groupResource{client: client}, &groupModel{
+		ID:   types.Int64Unknown(),
+		Name: types.StringValue("backend"),
+		Type: types.StringValue("group"),
+		Tags: types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+	})
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+
+	if id := testStateID(t, resp.State); id.ValueInt64() != monitorID {
+		t.Fatalf("expected group %d in state, got %s", monitorID, id)
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type GrpcKeywordMonitorResourceModel struct {
+	MonitorBaseModel
+
+	GrpcURL         types.String  `tfsdk:"grpc_url"`
+	GrpcProtobuf    types.String  `tfsdk:"grpc_protobuf"`
+	GrpcServiceName types.String  `tfsdk:"grpc_service_name"`
+	GrpcMethod      types.String  `tfsdk:"grpc_method"`
+	GrpcEnableTLS   types.Bool    `tfsdk:"grpc_enable_tls"`
+	GrpcBody        types.Dynamic `tfsdk:"grpc_body"`
+	GrpcMetadata    types.Map     `tfsdk:"grpc_metadata"`
+	Keyword         types.String  `tfsdk:"keyword"`
+	InvertKeyword   types.Bool    `tfsdk:"invert_keyword"`
+}
+
+func (m *GrpcKeywordMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, diags := m.MonitorBaseModel.Convert()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	monitor.GRPCURL = m.GrpcURL.ValueString()
+	monitor.GRPCProtobuf = m.GrpcProtobuf.ValueString()
+	monitor.GRPCServiceName = m.GrpcServiceName.ValueString()
+	monitor.GRPCMethod = m.GrpcMethod.ValueString()
+	monitor.GRPCEnableTLS = m.GrpcEnableTLS.ValueBool()
+	monitor.Keyword = m.Keyword.ValueString()
+	monitor.InvertKeyword = m.InvertKeyword.ValueBool()
+
+	body, err := json.Marshal(jsonFromValue(m.GrpcBody))
+	if err != nil {
+		diags.AddAttributeError(path.Root("grpc_body"), "Invalid gRPC Body", err.Error())
+		return nil, diags
+	}
+	monitor.GRPCBody = string(body)
+
+	metadata := make(map[string]string, len(m.GrpcMetadata.Elements()))
+	for key, value := range m.GrpcMetadata.Elements() {
+		if value, ok := value.(types.String); ok {
+			metadata[key] = value.ValueString()
+		}
+	}
+
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		diags.AddAttributeError(path.Root("grpc_metadata"), "Invalid gRPC Metadata", err.Error())
+		return nil, diags
+	}
+	monitor.GRPCMetadata = string(encoded)
+
+	return monitor, nil
+}
+
+func (m *GrpcKeywordMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.GrpcURL = types.StringValue(stu.GRPCURL)
+	m.GrpcProtobuf = types.StringValue(stu.GRPCProtobuf)
+	m.GrpcServiceName = types.StringValue(stu.GRPCServiceName)
+	m.GrpcMethod = types.StringValue(stu.GRPCMethod)
+	m.GrpcEnableTLS = types.BoolValue(stu.GRPCEnableTLS)
+	m.Keyword = types.StringValue(stu.Keyword)
+	m.InvertKeyword = types.BoolValue(stu.InvertKeyword)
+
+	body, err := valueFromJSON(stu.GRPCBody)
+	if err != nil {
+		diags.AddAttributeError(path.Root("grpc_body"), "Invalid gRPC Body", fmt.Sprintf("Uptime Kuma returned a body that is not JSON: %s", err))
+		return diags
+	}
+	m.GrpcBody = types.DynamicValue(body)
+
+	metadata := map[string]string{}
+	if stu.GRPCMetadata != "" {
+		if err := json.Unmarshal([]byte(stu.GRPCMetadata), &metadata); err != nil {
+			diags.AddAttributeError(path.Root("grpc_metadata"), "Invalid gRPC Metadata", fmt.Sprintf("Uptime Kuma returned metadata that is not a JSON object of strings: %s", err))
+			return diags
+		}
+	}
+
+	elements := make(map[string]attr.Value, len(metadata))
+	for key, value := range metadata {
+		elements[key] = types.StringValue(value)
+	}
+
+	var d diag.Diagnostics
+	m.GrpcMetadata, d = types.MapValue(types.StringType, elements)
+	diags.Append(d...)
+
+	diags.Append(m.MonitorBaseModel.ConvertFrom(stu)...)
+
+	return diags
+}
+
+// jsonFromValue converts a Terraform value to the equivalent JSON value.
+// Numbers keep their precision.
+func jsonFromValue(value attr.Value) any {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return jsonFromValue(v.UnderlyingValue())
+	case basetypes.ObjectValue:
+		return jsonFromElements(v.Attributes())
+	case basetypes.MapValue:
+		return jsonFromElements(v.Elements())
+	case basetypes.TupleValue:
+		return jsonFromList(v.Elements())
+	case basetypes.ListValue:
+		return jsonFromList(v.Elements())
+	case basetypes.SetValue:
+		return jsonFromList(v.Elements())
+	case basetypes.StringValue:
+		return v.ValueString()
+	case basetypes.BoolValue:
+		return v.ValueBool()
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('g', -1))
+	case basetypes.Int64Value:
+		return v.ValueInt64()
+	case basetypes.Float64Value:
+		return v.ValueFloat64()
+	}
+
+	return nil
+}
+
+func jsonFromElements(elements map[string]attr.Value) map[string]any {
+	out := make(map[string]any, len(elements))
+	for key, element := range elements {
+		out[key] = jsonFromValue(element)
+	}
+	return out
+}
+
+func jsonFromList(elements []attr.Value) []any {
+	out := make([]any, 0, len(elements))
+	for _, element := range elements {
+		out = append(out, jsonFromValue(element))
+	}
+	return out
+}
+
+// valueFromJSON converts a JSON document to the value Terraform builds from
+// the same document written in HCL: objects and arrays become object and
+// tuple values. An empty document is an empty object.
+func valueFromJSON(document string) (attr.Value, error) {
+	if document == "" {
+		document = "{}"
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return valueFromDecoded(decoded)
+}
+
+func valueFromDecoded(decoded any) (attr.Value, error) {
+	switch v := decoded.(type) {
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		values := make(map[string]attr.Value, len(v))
+		for key, element := range v {
+			value, err := valueFromDecoded(element)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[key] = value.Type(context.Background())
+			values[key] = value
+		}
+		object, diags := types.ObjectValue(attrTypes, values)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting object: %v", diags)
+		}
+		return object, nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(v))
+		values := make([]attr.Value, 0, len(v))
+		for _, element := range v {
+			value, err := valueFromDecoded(element)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, value.Type(context.Background()))
+			values = append(values, value)
+		}
+		tuple, diags := types.TupleValue(elementTypes, values)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting array: %v", diags)
+		}
+		return tuple, nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(number), nil
+	case nil:
+		return types.DynamicNull(), nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON value %T", decoded)
+}
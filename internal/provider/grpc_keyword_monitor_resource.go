@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &grpcKeywordMonitorResource{}
+var _ resource.ResourceWithImportState = &grpcKeywordMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &grpcKeywordMonitorResource{}
+
+func NewGrpcKeywordMonitorResource() resource.Resource {
+	return &grpcKeywordMonitorResource{}
+}
+
+// grpcKeywordMonitorResource manages monitors that call a gRPC method and
+// search the response for a keyword.
+type grpcKeywordMonitorResource struct {
+	monitorResource[GrpcKeywordMonitorResourceModel, *GrpcKeywordMonitorResourceModel]
+}
+
+func (r *grpcKeywordMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grpc_keyword_monitor"
+}
+
+func (r *grpcKeywordMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a monitor that calls a gRPC method and is up when the response contains a keyword.",
+
+		Attributes: monitorAttributes("grpc-keyword", map[string]schema.Attribute{
+			"grpc_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Address of the gRPC server as `host:port`, for example `api.example.com:50051`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"grpc_protobuf": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Proto definition of the service, inline or read with `file()`. defaults to `\"\"`.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"grpc_service_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Fully qualified service name, for example `grpc.health.v1.Health`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"grpc_method": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Method of the service to call, for example `Check`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"grpc_enable_tls": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Connect with TLS. defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"grpc_body": schema.DynamicAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Request message as an object, sent JSON-encoded, for example `{ service = \"\" }`. defaults to `{}`.",
+				Default:             dynamicdefault.StaticValue(types.DynamicValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}))),
+				Validators: []validator.Dynamic{
+					objectValueValidator{},
+				},
+			},
+			"grpc_metadata": schema.MapAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Metadata sent with the request, JSON-encoded. defaults to `{}`.",
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"keyword": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Keyword to search for in the JSON-encoded response. The search is case-sensitive.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"invert_keyword": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Mark the monitor down when the keyword is found instead of when it is missing. defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+		}),
+	}
+}
+
+// objectValueValidator rejects dynamic values that are not objects. Maps are
+// rejected too: the body is read back from JSON as an object, so a map would
+// not match the planned value.
+type objectValueValidator struct{}
+
+func (v objectValueValidator) Description(_ context.Context) string {
+	return "value must be an object"
+}
+
+func (v objectValueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v objectValueValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	if _, ok := req.ConfigValue.UnderlyingValue().(basetypes.ObjectValue); ok {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		"Attribute "+req.Path.String()+" "+v.Description(ctx)+", for example { service = \"\" }.",
+	)
+}
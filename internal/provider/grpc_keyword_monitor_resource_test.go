@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestGrpcBodyRoundTrip(t *testing.T) {
+	for _, document := range []string{
+		`{}`,
+		`{"service":""}`,
+		`{"filter":{"ids":[1,2.5,"three"],"active":true},"limit":10000000000000000000}`,
+	} {
+		value, err := valueFromJSON(document)
+		if err != nil {
+			t.Fatalf("valueFromJSON(%s): %v", document, err)
+		}
+
+		encoded, err := json.Marshal(jsonFromValue(value))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var want, got any
+		_ = json.Unmarshal([]byte(document), &want)
+		_ = json.Unmarshal(encoded, &got)
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("round trip of %s gave %s", document, encoded)
+		}
+	}
+
+	if _, err := valueFromJSON(`{"service":`); err == nil {
+		t.Error("expected an error for truncated JSON")
+	}
+}
+
+const testAccGrpcHealthProto = `<<-EOT
+    syntax = "proto3";
+
+    package grpc.health.v1;
+
+    service Health {
+      rpc Check(HealthCheckRequest) returns (HealthCheckResponse);
+    }
+
+    message HealthCheckRequest {
+      string service = 1;
+    }
+
+    message HealthCheckResponse {
+      enum ServingStatus {
+        UNKNOWN = 0;
+        SERVING = 1;
+        NOT_SERVING = 2;
+      }
+      ServingStatus status = 1;
+    }
+  EOT`
+
+func TestAccGrpcKeywordMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_grpc_keyword_monitor" "test" {
+  name              = "orders"
+  grpc_url          = "orders.internal:50051"
+  grpc_service_name = "grpc.health.v1.Health"
+  grpc_method       = "Check"
+  grpc_body         = "service"
+  keyword           = "SERVING"
+}
+`,
+				ExpectError: regexp.MustCompile(`grpc_body value must be an object`),
+			},
+			// A map would be read back as an object.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_grpc_keyword_monitor" "test" {
+  name              = "orders"
+  grpc_url          = "orders.internal:50051"
+  grpc_service_name = "grpc.health.v1.Health"
+  grpc_method       = "Check"
+  grpc_body         = tomap({ service = "orders" })
+  keyword           = "SERVING"
+}
+`,
+				ExpectError: regexp.MustCompile(`grpc_body value must be an object`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_grpc_keyword_monitor" "test" {
+  name              = "orders"
+  grpc_url          = "orders.internal:50051"
+  grpc_protobuf     = ` + testAccGrpcHealthProto + `
+  grpc_service_name = "grpc.health.v1.Health"
+  grpc_method       = "Check"
+  grpc_body = {
+    service = "orders"
+  }
+  grpc_metadata = {
+    authorization = "Bearer token"
+  }
+  keyword = "SERVING"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "type", "grpc-keyword"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "grpc_body.service", "orders"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "grpc_metadata.authorization", "Bearer token"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "grpc_enable_tls", "false"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "invert_keyword", "false"),
+					testAccCheckMonitor(server, "kuma_grpc_keyword_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.GRPCBody != `{"service":"orders"}` || monitor.GRPCMetadata != `{"authorization":"Bearer token"}` {
+							return fmt.Errorf("unexpected body or metadata: %q, %q", monitor.GRPCBody, monitor.GRPCMetadata)
+						}
+						if monitor.GRPCServiceName != "grpc.health.v1.Health" || monitor.GRPCMethod != "Check" || monitor.GRPCProtobuf == "" {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_grpc_keyword_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place with a nested body.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_grpc_keyword_monitor" "test" {
+  name              = "orders"
+  grpc_url          = "orders.internal:443"
+  grpc_protobuf     = ` + testAccGrpcHealthProto + `
+  grpc_service_name = "grpc.health.v1.Health"
+  grpc_method       = "Check"
+  grpc_enable_tls   = true
+  grpc_body = {
+    service = "orders"
+    options = {
+      regions = ["eu", "us"]
+      timeout = 1.5
+      deep    = true
+    }
+  }
+  keyword        = "NOT_SERVING"
+  invert_keyword = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_grpc_keyword_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "grpc_enable_tls", "true"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "grpc_body.options.regions.1", "us"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "grpc_metadata.%", "0"),
+					resource.TestCheckResourceAttr("kuma_grpc_keyword_monitor.test", "invert_keyword", "true"),
+					testAccCheckMonitor(server, "kuma_grpc_keyword_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.GRPCBody != `{"options":{"deep":true,"regions":["eu","us"],"timeout":1.5},"service":"orders"}` {
+							return fmt.Errorf("unexpected body: %q", monitor.GRPCBody)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
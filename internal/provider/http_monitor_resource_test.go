@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumamock"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccHttpMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.AddTag(kuma.Tag{Name: "env", Color: "#2563EB"})
+	server.AddTag(kuma.Tag{Name: "team", Color: "#059669"})
+	defaultNotification := server.AddNotification(kuma.Notification{Name: "ops", Type: "slack", Active: true, IsDefault: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_http_monitor" "test" {
+  name     = "example"
+  url      = "https://example.com"
+  interval = 60
+
+  tags = {
+    env = "prod"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "name", "example"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "type", "http"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "http_option_method", "GET"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "accepted_statuscodes.#", "1"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "accepted_statuscodes.0", "200-299"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "notification_list.#", "1"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "notification_list.0", strconv.FormatInt(defaultNotification.ID, 10)),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.env", "prod"),
+					resource.TestCheckResourceAttrSet("kuma_http_monitor.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_http_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_http_monitor" "test" {
+  name        = "example-renamed"
+  description = "updated"
+  url         = "https://example.org"
+  interval    = 120
+
+  accepted_statuscodes = ["200-299", "300-399"]
+
+  tags = {
+    env  = "staging"
+    team = "sre"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_http_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "name", "example-renamed"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "description", "updated"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "url", "https://example.org"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "interval", "120"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "accepted_statuscodes.#", "2"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.env", "staging"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.team", "sre"),
+				),
+			},
+			// Drift: the monitor is changed in the UI and gets reverted.
+			{
+				PreConfig: func() {
+					for _, monitor := range server.Monitors() {
+						server.UpdateMonitor(monitor.ID, func(m *kuma.Monitor) {
+							m.Url = "https://changed.example.com"
+						})
+					}
+				},
+				Config: testAccProviderConfig(server) + `
+resource "kuma_http_monitor" "test" {
+  name        = "example-renamed"
+  description = "updated"
+  url         = "https://example.org"
+  interval    = 120
+
+  accepted_statuscodes = ["200-299", "300-399"]
+
+  tags = {
+    env  = "staging"
+    team = "sre"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_http_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "url", "https://example.org"),
+				),
+			},
+			// Drift: the monitor is deleted in the UI and gets recreated.
+			{
+				PreConfig: func() {
+					for _, monitor := range server.Monitors() {
+						server.DeleteMonitor(monitor.ID)
+					}
+				},
+				Config: testAccProviderConfig(server) + `
+resource "kuma_http_monitor" "test" {
+  name = "example-renamed"
+  url  = "https://example.org"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_http_monitor.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "name", "example-renamed"),
+					testAccCheckMonitorExists(server, "kuma_http_monitor.test"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestHttpMonitorResourceCreateTagFailure(t *testing.T) {
+	client := kumamock.NewMockAPI(gomock.NewController(t))
+	monitorID := int64(42)
+
+	client.EXPECT().GetDefaultNotifications(gomock.Any()).Return(nil, nil)
+	client.EXPECT().CreateMonitor(gomock.Any(), gomock.Any()).Return(&monitorID, nil)
+	client.EXPECT().CreateMonitorTag(gomock.Any(), monitorID, kuma.MonitorTag{Name: "env", Value: "prod"}).Return(errors.New("tag attach failed"))
+
+	r := &httpMonitorResource{}
+	r.client = client
+
+	resp := testCreate(t, r, &HttpMonitorResourceModel{
+		MonitorBaseModel: MonitorBaseModel{
+			ID:                 types.Int64Unknown(),
+			Name:               types.StringValue("example"),
+			Type:               types.StringValue("http"),
+			NotificationIDList: types.ListNull(types.Int64Type),
+			Tags:               types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+		},
+		Url:                 types.StringValue("https://example.com"),
+		Method:              types.StringValue("GET"),
+		AcceptedStatusCodes: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("200-299")}),
+	})
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+
+	// The monitor exists on the server, so it must stay in state to be
+	// tainted and replaced rather than leaked.
+	if id := testStateID(t, resp.State); id.ValueInt64() != monitorID {
+		t.Fatalf("expected monitor %d in state, got %s", monitorID, id)
+	}
+}
+
+// testAccCheckMonitorExists verifies the monitor in state exists on the server.
+func testAccCheckMonitorExists(server *kumatest.Server, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := server.Monitor(id); !ok {
+			return fmt.Errorf("monitor %d does not exist on the server", id)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckMonitor runs check against the monitor in state as stored on
+// the server.
+func testAccCheckMonitor(server *kumatest.Server, resourceName string, check func(kuma.Monitor) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		monitor, ok := server.Monitor(id)
+		if !ok {
+			return fmt.Errorf("monitor %d does not exist on the server", id)
+		}
+
+		return check(monitor)
+	}
+}
+
+// testAccCheckMonitorsDestroyed verifies no monitor is left on the server.
+func testAccCheckMonitorsDestroyed(server *kumatest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if monitors := server.Monitors(); len(monitors) > 0 {
+			return fmt.Errorf("expected all monitors to be destroyed, found %d", len(monitors))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type JsonQueryMonitorResourceModel struct {
+	HttpMonitorResourceModel
+
+	JsonQuery     types.String `tfsdk:"json_query"`
+	ExpectedValue types.String `tfsdk:"expected_value"`
+	Operator      types.String `tfsdk:"json_query_operator"`
+}
+
+func (m *JsonQueryMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.HttpMonitorResourceModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.JSONPath = m.JsonQuery.ValueString()
+	monitor.ExpectedValue = m.ExpectedValue.ValueString()
+	monitor.JSONPathOperator = m.Operator.ValueString()
+
+	return monitor, nil
+}
+
+func (m *JsonQueryMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.JsonQuery = types.StringValue(stu.JSONPath)
+	m.ExpectedValue = types.StringValue(stu.ExpectedValue)
+	// Uptime Kuma before 2.0 has no operator and always compares with ==.
+	m.Operator = types.StringValue(stu.JSONPathOperator)
+	if stu.JSONPathOperator == "" {
+		m.Operator = types.StringValue("==")
+	}
+
+	return m.HttpMonitorResourceModel.ConvertFrom(stu)
+}
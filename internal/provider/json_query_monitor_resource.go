@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jsonQueryMonitorResource{}
+var _ resource.ResourceWithImportState = &jsonQueryMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &jsonQueryMonitorResource{}
+
+func NewJsonQueryMonitorResource() resource.Resource {
+	return &jsonQueryMonitorResource{
+		monitorResource: monitorResource[JsonQueryMonitorResourceModel, *JsonQueryMonitorResourceModel]{
+			requirements: []serverRequirement{
+				{MinVersion: "1.23.0", Feature: "The kuma_json_query_monitor resource"},
+				{Path: path.Root("json_query_operator"), MinVersion: "2.0.0", Feature: "The json_query_operator attribute"},
+			},
+		},
+	}
+}
+
+// jsonQueryMonitorResource manages HTTP monitors that evaluate a JSONata
+// expression against the JSON response.
+type jsonQueryMonitorResource struct {
+	monitorResource[JsonQueryMonitorResourceModel, *JsonQueryMonitorResourceModel]
+}
+
+func (r *jsonQueryMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_json_query_monitor"
+}
+
+func (r *jsonQueryMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an HTTP monitor that is up when a [JSONata](https://jsonata.org) query on the JSON response matches the expected value.",
+
+		Attributes: monitorAttributes("json-query", httpAttributes(), map[string]schema.Attribute{
+			"json_query": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "JSONata expression evaluated against the response, for example `status`.",
+				Validators: []validator.String{
+					jsonQueryValidator{},
+				},
+			},
+			"expected_value": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Value the result of the query is compared with.",
+			},
+			"json_query_operator": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Operator comparing the result with `expected_value`, one of `==`, `!=`, `<`, `<=`, `>`, `>=` and `contains`. Defaults to `==`. Setting another operator requires Uptime Kuma 2.0.",
+				Default:             stringdefault.StaticString("=="),
+				Validators: []validator.String{
+					stringvalidator.OneOf("==", "!=", "<", "<=", ">", ">=", "contains"),
+				},
+			},
+		}),
+	}
+}
+
+// jsonQueryValidator rejects JSONata expressions that cannot parse because a
+// bracket, string, regular expression or comment is left open. It does not
+// evaluate the expression, Kuma reports remaining errors when it runs.
+type jsonQueryValidator struct{}
+
+func (v jsonQueryValidator) Description(_ context.Context) string {
+	return "value must be a well-formed JSONata expression"
+}
+
+func (v jsonQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonQueryValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkJSONQuery(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Query",
+			fmt.Sprintf("The JSONata expression %q is not valid: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// checkJSONQuery scans a JSONata expression for unbalanced brackets and
+// unterminated strings, regular expressions and comments.
+func checkJSONQuery(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return errors.New("the expression is empty")
+	}
+
+	pairs := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	runes := []rune(expr)
+
+	var open []int
+
+	// operand is set after a token that ends an operand, where a slash
+	// divides instead of starting a regular expression.
+	operand := false
+
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		switch {
+		case c == '"' || c == '\'' || c == '`':
+			end := closeQuote(runes, i, c)
+			if end < 0 {
+				return fmt.Errorf("unterminated string at offset %d", i)
+			}
+			i, operand = end, true
+
+		case c == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				return fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			// Skip past the closing slash.
+			i += 2 + utf8.RuneCountInString(string(runes[i+2:])[:end]) + 1
+
+		case c == '/' && !operand:
+			end := closeQuote(runes, i, '/')
+			if end < 0 {
+				return fmt.Errorf("unterminated regular expression at offset %d", i)
+			}
+			i = end
+			// Skip the flags.
+			for i+1 < len(runes) && (runes[i+1] == 'i' || runes[i+1] == 'm') {
+				i++
+			}
+			operand = true
+
+		case c == '(' || c == '[' || c == '{':
+			open = append(open, i)
+			operand = false
+
+		case c == ')' || c == ']' || c == '}':
+			if len(open) == 0 || runes[open[len(open)-1]] != pairs[c] {
+				return fmt.Errorf("unexpected %q at offset %d", c, i)
+			}
+			open = open[:len(open)-1]
+			operand = true
+
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+
+		default:
+			// Names, variables and numbers are operands, the rest are
+			// operators.
+			operand = c == '_' || c == '$' || c > 127 ||
+				c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+		}
+	}
+
+	if len(open) > 0 {
+		last := open[len(open)-1]
+		return fmt.Errorf("%q at offset %d is never closed", runes[last], last)
+	}
+
+	return nil
+}
+
+// closeQuote returns the index of the quote closing the one at start, or -1.
+// Backslash escapes apply to everything but backtick-quoted names.
+func closeQuote(runes []rune, start int, quote rune) int {
+	for i := start + 1; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && quote != '`':
+			i++
+		case runes[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
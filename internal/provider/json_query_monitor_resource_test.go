@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestCheckJSONQuery(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{"status", true},
+		{`$.status = "UP"`, true},
+		{"Account.Order[0].Product.(Price * Quantity)", true},
+		{`$count(items[type = "db" and healthy = false]) = 0`, true},
+		{"total / 2 > 10", true},
+		{`$contains(message, /ok[!]?/i)`, true},
+		{"`weird ] name`.value", true},
+		{`/* ignore ( this */ status`, true},
+		{`"it's \"quoted\""`, true},
+		{"", false},
+		{"   ", false},
+		{"items[0", false},
+		{"items]", false},
+		{"(a + b]", false},
+		{`status = "UP`, false},
+		{"$match(name, /[a-z+)", false},
+		{"/* never closed", false},
+	}
+
+	for _, tt := range tests {
+		if err := checkJSONQuery(tt.expr); (err == nil) != tt.valid {
+			t.Errorf("checkJSONQuery(%q) = %v, want valid %v", tt.expr, err, tt.valid)
+		}
+	}
+}
+
+func TestAccJsonQueryMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.Version = "2.0.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_json_query_monitor" "test" {
+  name           = "health"
+  url            = "https://example.com/health"
+  json_query     = "status[0"
+  expected_value = "UP"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid JSON Query`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_json_query_monitor" "test" {
+  name           = "health"
+  url            = "https://example.com/health"
+  json_query     = "status"
+  expected_value = "UP"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_json_query_monitor.test", "type", "json-query"),
+					resource.TestCheckResourceAttr("kuma_json_query_monitor.test", "json_query", "status"),
+					resource.TestCheckResourceAttr("kuma_json_query_monitor.test", "expected_value", "UP"),
+					resource.TestCheckResourceAttr("kuma_json_query_monitor.test", "json_query_operator", "=="),
+					testAccCheckMonitor(server, "kuma_json_query_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Type != "json-query" || monitor.JSONPath != "status" || monitor.ExpectedValue != "UP" {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_json_query_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_json_query_monitor" "test" {
+  name                = "health"
+  url                 = "https://example.com/health"
+  json_query          = "$count(checks[status != \"UP\"])"
+  expected_value      = "1"
+  json_query_operator = "<"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_json_query_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_json_query_monitor.test", "json_query_operator", "<"),
+					testAccCheckMonitor(server, "kuma_json_query_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.JSONPath != `$count(checks[status != "UP"])` || monitor.JSONPathOperator != "<" {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// Removing the operator restores the default.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_json_query_monitor" "test" {
+  name           = "health"
+  url            = "https://example.com/health"
+  json_query     = "$count(checks[status != \"UP\"])"
+  expected_value = "1"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_json_query_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_json_query_monitor.test", "json_query_operator", "=="),
+					testAccCheckMonitor(server, "kuma_json_query_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.JSONPathOperator != "==" {
+							return fmt.Errorf("expected operator ==, got %q", monitor.JSONPathOperator)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccJsonQueryMonitorResourceOperatorUnsupported(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_json_query_monitor" "test" {
+  name                = "health"
+  url                 = "https://example.com/health"
+  json_query          = "status"
+  expected_value      = "UP"
+  json_query_operator = "!="
+}
+`,
+				ExpectError: regexp.MustCompile(`The json_query_operator attribute requires Uptime Kuma 2\.0\.0 or later`),
+			},
+		},
+	})
+}
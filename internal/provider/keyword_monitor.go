@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type KeywordMonitorResourceModel struct {
+	HttpMonitorResourceModel
+
+	Keyword       types.String `tfsdk:"keyword"`
+	InvertKeyword types.Bool   `tfsdk:"invert_keyword"`
+}
+
+func (m *KeywordMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.HttpMonitorResourceModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.Keyword = m.Keyword.ValueString()
+	monitor.InvertKeyword = m.InvertKeyword.ValueBool()
+
+	return monitor, nil
+}
+
+func (m *KeywordMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.Keyword = types.StringValue(stu.Keyword)
+	m.InvertKeyword = types.BoolValue(stu.InvertKeyword)
+
+	return m.HttpMonitorResourceModel.ConvertFrom(stu)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &keywordMonitorResource{}
+var _ resource.ResourceWithImportState = &keywordMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &keywordMonitorResource{}
+
+func NewKeywordMonitorResource() resource.Resource {
+	return &keywordMonitorResource{}
+}
+
+// keywordMonitorResource manages HTTP monitors that also search the response
+// body for a keyword.
+type keywordMonitorResource struct {
+	monitorResource[KeywordMonitorResourceModel, *KeywordMonitorResourceModel]
+}
+
+func (r *keywordMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keyword_monitor"
+}
+
+func (r *keywordMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an HTTP monitor that is up when the response body contains a keyword.",
+
+		Attributes: monitorAttributes("keyword", httpAttributes(), map[string]schema.Attribute{
+			"keyword": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Keyword to search for in the response body. The search is case-sensitive.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"invert_keyword": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Mark the monitor down when the keyword is found instead of when it is missing. defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+		}),
+	}
+}
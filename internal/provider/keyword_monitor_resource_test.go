@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccKeywordMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_keyword_monitor" "test" {
+  name    = "status-page"
+  url     = "https://example.com/status"
+  keyword = "OK"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "type", "keyword"),
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "keyword", "OK"),
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "invert_keyword", "false"),
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "http_option_method", "GET"),
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "accepted_statuscodes.0", "200-299"),
+					testAccCheckMonitor(server, "kuma_keyword_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Type != "keyword" || monitor.Keyword != "OK" || monitor.Url != "https://example.com/status" {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_keyword_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_keyword_monitor" "test" {
+  name           = "status-page"
+  url            = "https://example.com/status"
+  keyword        = "maintenance"
+  invert_keyword = true
+
+  http_option_method = "POST"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_keyword_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "keyword", "maintenance"),
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "invert_keyword", "true"),
+					resource.TestCheckResourceAttr("kuma_keyword_monitor.test", "http_option_method", "POST"),
+					testAccCheckMonitor(server, "kuma_keyword_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Keyword != "maintenance" || !monitor.InvertKeyword {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -2,19 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"terraform-provider-kuma/internal/kuma"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-type MonitorResourceModel struct {
+// MonitorBaseModel holds the attributes every monitor resource has.
+type MonitorBaseModel struct {
 	ID          types.Int64  `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Parent      types.Int64  `tfsdk:"parent"`
-	Url         types.String `tfsdk:"url"`
 	Type        types.String `tfsdk:"type"`
 	// Active      types.Bool   `tfsdk:"active"`
 	// Timeout        types.Int64 `tfsdk:"timeout"`
@@ -22,7 +26,19 @@ type MonitorResourceModel struct {
 	RetryInterval  types.Int64 `tfsdk:"retry_interval"`
 	ResendInterval types.Int64 `tfsdk:"resend_interval"`
 	MaxRetries     types.Int64 `tfsdk:"max_retries"`
-	MaxRedirects   types.Int64 `tfsdk:"max_redirects"`
+
+	NotificationIDList types.List `tfsdk:"notification_list"`
+	UpsideDown         types.Bool `tfsdk:"upside_down"`
+	Tags               types.Map  `tfsdk:"tags"`
+}
+
+// HttpMonitorResourceModel is the model of kuma_http_monitor, and the base of
+// the other monitors that make HTTP requests.
+type HttpMonitorResourceModel struct {
+	MonitorBaseModel
+
+	Url          types.String `tfsdk:"url"`
+	MaxRedirects types.Int64  `tfsdk:"max_redirects"`
 
 	Method           types.String `tfsdk:"http_option_method"`
 	HTTPBodyEncoding types.String `tfsdk:"http_option_body_encoding"`
@@ -30,11 +46,8 @@ type MonitorResourceModel struct {
 	Headers          types.String `tfsdk:"http_option_headers"`
 
 	AcceptedStatusCodes types.List `tfsdk:"accepted_statuscodes"`
-	NotificationIDList  types.List `tfsdk:"notification_list"`
 	ExpiryNotification  types.Bool `tfsdk:"expiry_notification"`
 	IgnoreTls           types.Bool `tfsdk:"ignore_tls"`
-	UpsideDown          types.Bool `tfsdk:"upside_down"`
-	Tags                types.Map  `tfsdk:"tags"`
 }
 
 type MonitorTag struct {
@@ -43,11 +56,10 @@ type MonitorTag struct {
 	Value types.String `tfsdk:"value"`
 }
 
-func (m *MonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+func (m *MonitorBaseModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
 	ctx := context.Background()
 	var tmpTag map[string]string
 	var newTag []kuma.MonitorTag
-	var newAcceptedStatusCodes []string
 	var newNotificationIDList []int64
 
 	if !m.Tags.IsNull() || !m.Tags.IsUnknown() {
@@ -64,68 +76,49 @@ func (m *MonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
 		})
 	}
 
-	err := m.AcceptedStatusCodes.ElementsAs(ctx, &newAcceptedStatusCodes, true)
-	if err.HasError() {
-		return nil, err
-	}
-	err = m.NotificationIDList.ElementsAs(ctx, &newNotificationIDList, true)
+	err := m.NotificationIDList.ElementsAs(ctx, &newNotificationIDList, true)
 	if err.HasError() {
 		return nil, err
 	}
 
 	return &kuma.Monitor{
-		ID:                  m.ID.ValueInt64(),
-		Name:                m.Name.ValueString(),
-		Description:         m.Description.ValueString(),
-		Parent:              m.Parent.ValueInt64(),
-		Url:                 m.Url.ValueString(),
-		Method:              m.Method.ValueString(),
-		HTTPBodyEncoding:    m.HTTPBodyEncoding.ValueString(),
-		Body:                m.Body.ValueString(),
-		Headers:             m.Headers.ValueString(),
-		Type:                m.Type.ValueString(),
-		Interval:            m.Interval.ValueInt64(),
-		RetryInterval:       m.RetryInterval.ValueInt64(),
-		ResendInterval:      m.ResendInterval.ValueInt64(),
-		MaxRetries:          m.MaxRetries.ValueInt64(),
-		MaxRedirects:        m.MaxRedirects.ValueInt64(),
-		AcceptedStatusCodes: newAcceptedStatusCodes,
-		NotificationIDList:  newNotificationIDList,
-		ExpiryNotification:  m.ExpiryNotification.ValueBool(),
-		IgnoreTls:           m.IgnoreTls.ValueBool(),
-		UpsideDown:          m.UpsideDown.ValueBool(),
-		Tags:                newTag,
+		ID:                 m.ID.ValueInt64(),
+		Name:               m.Name.ValueString(),
+		Description:        m.Description.ValueString(),
+		Parent:             m.Parent.ValueInt64(),
+		Type:               m.Type.ValueString(),
+		Interval:           m.Interval.ValueInt64(),
+		RetryInterval:      m.RetryInterval.ValueInt64(),
+		ResendInterval:     m.ResendInterval.ValueInt64(),
+		MaxRetries:         m.MaxRetries.ValueInt64(),
+		NotificationIDList: newNotificationIDList,
+		UpsideDown:         m.UpsideDown.ValueBool(),
+		Tags:               newTag,
 	}, nil
 }
 
-func (m *MonitorResourceModel) ConvertFrom(stu kuma.Monitor) (err diag.Diagnostics) {
+func (m *MonitorBaseModel) ConvertFrom(stu kuma.Monitor) (err diag.Diagnostics) {
 	ctx := context.Background()
 
 	m.ID = types.Int64Value(stu.ID)
 	m.Name = types.StringValue(stu.Name)
 	m.Description = types.StringValue(stu.Description)
 	m.Parent = types.Int64Value(stu.Parent)
-	m.Url = types.StringValue(stu.Url)
-	m.Method = types.StringValue(stu.Method)
-	m.HTTPBodyEncoding = types.StringValue(stu.HTTPBodyEncoding)
-	m.Body = types.StringValue(stu.Body)
-	m.Headers = types.StringValue(stu.Headers)
 	m.Type = types.StringValue(stu.Type)
 	m.Interval = types.Int64Value(stu.Interval)
 	m.RetryInterval = types.Int64Value(stu.RetryInterval)
 	m.ResendInterval = types.Int64Value(stu.ResendInterval)
-	m.MaxRedirects = types.Int64Value(stu.MaxRedirects)
 	m.MaxRetries = types.Int64Value(stu.MaxRetries)
-	m.ExpiryNotification = types.BoolValue(stu.ExpiryNotification)
-	m.IgnoreTls = types.BoolValue(stu.IgnoreTls)
 	m.UpsideDown = types.BoolValue(stu.UpsideDown)
 
-	m.AcceptedStatusCodes, err = types.ListValueFrom(ctx, types.StringType, stu.AcceptedStatusCodes)
-	if err.HasError() {
-		return err
+	// No notifications is an empty list, not null, so that clearing the list
+	// reads back as planned.
+	notificationIDList := stu.NotificationIDList
+	if notificationIDList == nil {
+		notificationIDList = []int64{}
 	}
 
-	m.NotificationIDList, err = types.ListValueFrom(ctx, types.Int64Type, stu.NotificationIDList)
+	m.NotificationIDList, err = types.ListValueFrom(ctx, types.Int64Type, notificationIDList)
 	if err.HasError() {
 		return err
 	}
@@ -143,3 +136,63 @@ func (m *MonitorResourceModel) ConvertFrom(stu kuma.Monitor) (err diag.Diagnosti
 
 	return err
 }
+
+func (m *HttpMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	var newAcceptedStatusCodes []string
+
+	monitor, err := m.MonitorBaseModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	err = m.AcceptedStatusCodes.ElementsAs(context.Background(), &newAcceptedStatusCodes, true)
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.Url = m.Url.ValueString()
+	monitor.MaxRedirects = m.MaxRedirects.ValueInt64()
+	monitor.Method = m.Method.ValueString()
+	monitor.HTTPBodyEncoding = m.HTTPBodyEncoding.ValueString()
+	monitor.Body = m.Body.ValueString()
+	monitor.Headers = m.Headers.ValueString()
+	monitor.AcceptedStatusCodes = newAcceptedStatusCodes
+	monitor.ExpiryNotification = m.ExpiryNotification.ValueBool()
+	monitor.IgnoreTls = m.IgnoreTls.ValueBool()
+
+	return monitor, nil
+}
+
+func (m *HttpMonitorResourceModel) ConvertFrom(stu kuma.Monitor) (err diag.Diagnostics) {
+	err = m.MonitorBaseModel.ConvertFrom(stu)
+	if err.HasError() {
+		return err
+	}
+
+	m.Url = types.StringValue(stu.Url)
+	m.MaxRedirects = types.Int64Value(stu.MaxRedirects)
+	m.Method = types.StringValue(stu.Method)
+	m.HTTPBodyEncoding = types.StringValue(stu.HTTPBodyEncoding)
+	m.Body = types.StringValue(stu.Body)
+	m.Headers = types.StringValue(stu.Headers)
+	m.ExpiryNotification = types.BoolValue(stu.ExpiryNotification)
+	m.IgnoreTls = types.BoolValue(stu.IgnoreTls)
+
+	m.AcceptedStatusCodes, err = types.ListValueFrom(context.Background(), types.StringType, stu.AcceptedStatusCodes)
+
+	return err
+}
+
+// importMonitorState imports a monitor by its numeric ID.
+func importMonitorState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the numeric ID of the monitor, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -29,7 +29,7 @@ type monitorsModel struct {
 }
 
 type monitorsDataSource struct {
-	client *kuma.Client
+	client kuma.API
 }
 
 func (d *monitorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -62,7 +62,7 @@ func (d *monitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 func (d *monitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var states MonitorsDataSourceModel
 
-	monitors, err := d.client.GetMonitors()
+	monitors, err := d.client.GetMonitors(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read monotors",
@@ -94,11 +94,11 @@ func (d *monitorsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*kuma.Client)
+	client, ok := req.ProviderData.(kuma.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMonitorsDataSource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	web := server.AddMonitor(kuma.Monitor{Name: "web", Type: "http", Url: "https://example.com"})
+	server.AddMonitor(kuma.Monitor{Name: "backend", Type: "group"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "kuma_monitors" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_monitors.all", "monitors.#", "2"),
+					resource.TestCheckResourceAttr("data.kuma_monitors.all", "monitors.0.id", strconv.FormatInt(web, 10)),
+					resource.TestCheckResourceAttr("data.kuma_monitors.all", "monitors.0.name", "web"),
+					resource.TestCheckResourceAttr("data.kuma_monitors.all", "monitors.1.name", "backend"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,501 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// monitorModel is implemented by pointers to monitor resource models, all of
+// which embed MonitorBaseModel.
+type monitorModel[T any] interface {
+	*T
+	base() *MonitorBaseModel
+	Convert() (*kuma.Monitor, diag.Diagnostics)
+	ConvertFrom(stu kuma.Monitor) diag.Diagnostics
+}
+
+func (m *MonitorBaseModel) base() *MonitorBaseModel {
+	return m
+}
+
+// derivedModel is implemented by monitor models with attributes that are not
+// part of the monitor but derived from it through the client, such as URLs.
+type derivedModel interface {
+	derive(ctx context.Context, client kuma.API) diag.Diagnostics
+}
+
+// convertFrom fills the model from the monitor and derives the attributes
+// that need the client.
+func (r *monitorResource[T, M]) convertFrom(ctx context.Context, model M, monitor kuma.Monitor) diag.Diagnostics {
+	diags := model.ConvertFrom(monitor)
+	if d, ok := any(model).(derivedModel); ok && !diags.HasError() {
+		diags.Append(d.derive(ctx, r.client)...)
+	}
+	return diags
+}
+
+// monitorRequirements are the server versions the attributes shared by all
+// monitor types need.
+var monitorRequirements = []serverRequirement{
+	{Path: path.Root("parent"), MinVersion: "1.21.0", Feature: "The parent attribute"},
+}
+
+// monitorResource implements the lifecycle shared by the monitor resources.
+// Each monitor type embeds it and adds its own Metadata and Schema.
+type monitorResource[T any, M monitorModel[T]] struct {
+	client kuma.API
+
+	// requirements are the server versions the monitor type or its own
+	// attributes need, on top of monitorRequirements.
+	requirements []serverRequirement
+}
+
+func (r *monitorResource[T, M]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(kuma.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *monitorResource[T, M]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkServerRequirements(ctx, r.client, req, resp, slices.Concat(monitorRequirements, r.requirements))
+}
+
+func (r *monitorResource[T, M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan T
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, diags := M(&plan).Convert()
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	notifications, err := r.client.GetDefaultNotifications(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error get notifications",
+			"Could not get notifications, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	item.NotificationIDList = append(item.NotificationIDList, notifications...)
+
+	// Create new order and set the ID on the state.
+	monitorID, err := r.client.CreateMonitor(ctx, *item)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating monitor",
+			fmt.Sprintf("Could not create monitor, unexpected error: %+v", err),
+		)
+		return
+	}
+
+	// Record the monitor right away so that a failure below taints it
+	// instead of leaving it on the server untracked.
+	diags = resp.State.SetAttribute(ctx, path.Root("id"), *monitorID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var monitor *kuma.Monitor
+
+	for _, tag := range item.Tags {
+		if err = r.client.CreateMonitorTag(ctx, *monitorID, tag); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Kuma Monitor Tag",
+				fmt.Sprintf("Could not create Kuma Monitor tag %s, tags: %+v %s", item.Name, tag, err.Error()),
+			)
+			return
+		}
+	}
+
+	monitor, err = r.client.GetMonitor(ctx, *monitorID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kuma Monitor",
+			fmt.Sprintf("Could not read Kuma Monitor %s, ID: %d %s", item.Name, *monitorID, err.Error()),
+		)
+		return
+	}
+
+	diags = r.convertFrom(ctx, &plan, *monitor)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// removeIfNotFound drops a resource that was deleted outside of Terraform from
+// the state, so that it is recreated, and reports whether it did.
+func removeIfNotFound(ctx context.Context, err error, resp *resource.ReadResponse) bool {
+	if !errors.Is(err, kuma.ErrNotFound) {
+		return false
+	}
+	resp.State.RemoveResource(ctx)
+	return true
+}
+
+func (r *monitorResource[T, M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state, outputPlan T
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := M(&state).base()
+
+	monitor, err := r.client.GetMonitor(ctx, base.ID.ValueInt64())
+	if removeIfNotFound(ctx, err, resp) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kuma Monitor",
+			fmt.Sprintf("Could not read Kuma Monitor %s, ID: %d %s", base.Name.ValueString(), int(base.ID.ValueInt64()), err.Error()),
+		)
+		return
+	}
+
+	diags = r.convertFrom(ctx, &outputPlan, *monitor)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &outputPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *monitorResource[T, M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, outputPlan T
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := M(&plan).base()
+
+	item, diags := M(&plan).Convert()
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if err := r.client.UpdateMonitor(ctx, base.ID.ValueInt64(), *item); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Kuma Monitor",
+			fmt.Sprintf("Could not update Kuma Monitor %s, ID: %d %s", base.Name.ValueString(), int(base.ID.ValueInt64()), err.Error()),
+		)
+		return
+	}
+
+	monitor, err := r.client.GetMonitor(ctx, base.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kuma Monitor",
+			fmt.Sprintf("Could not read Kuma Monitor %s, ID: %d %s", base.Name.ValueString(), int(base.ID.ValueInt64()), err.Error()),
+		)
+		return
+	}
+
+	curTag := make(map[string]kuma.MonitorTag)
+	planTag := make(map[string]kuma.MonitorTag)
+
+	for _, tag := range monitor.Tags {
+		curTag[tag.Name] = tag
+	}
+	for _, tag := range item.Tags {
+		planTag[tag.Name] = tag
+	}
+
+	for name, tag := range curTag {
+		// check current tag isn't in plan tag
+		if _, ok := planTag[name]; !ok {
+			if err := r.client.DeleteMonitorTag(ctx, base.ID.ValueInt64(), tag); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Kuma Tag",
+					fmt.Sprintf("Could not Delete Kuma Tag %s, ID: %d %s", name, tag.TagId, err.Error()),
+				)
+				return
+			}
+		} else if ok && planTag[name].Value == tag.Value {
+			// delete unchange tag
+			delete(planTag, name)
+		} else if ok && planTag[name].Value != tag.Value {
+			// update changed tag
+			if err := r.client.DeleteMonitorTag(ctx, base.ID.ValueInt64(), tag); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Kuma Tag",
+					fmt.Sprintf("Could not Delete Kuma Tag %s, ID: %d %s", name, tag.TagId, err.Error()),
+				)
+				return
+			}
+		}
+	}
+
+	for tag := range planTag {
+		if err := r.client.CreateMonitorTag(ctx, base.ID.ValueInt64(), planTag[tag]); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Kuma Tag",
+				fmt.Sprintf("Could not create Kuma Tag %s, ID: %d %s", tag, planTag[tag].TagId, err.Error()),
+			)
+			return
+		}
+	}
+
+	monitor, err = r.client.GetMonitor(ctx, base.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kuma Monitor",
+			fmt.Sprintf("Could not read Kuma Monitor %s, ID: %d %s", base.Name.ValueString(), int(base.ID.ValueInt64()), err.Error()),
+		)
+		return
+	}
+
+	diags = r.convertFrom(ctx, &outputPlan, *monitor)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &outputPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *monitorResource[T, M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state T
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	base := M(&state).base()
+
+	// Delete existing order
+	err := r.client.DeleteMonitor(ctx, base.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Kuma Monitor",
+			fmt.Sprintf("Could not delete Kuma Monitor %s, ID: %d %s", base.Name.ValueString(), int(base.ID.ValueInt64()), err.Error()),
+		)
+		return
+	}
+}
+
+func (r *monitorResource[T, M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importMonitorState(ctx, req, resp)
+}
+
+// monitorAttributes returns the schema attributes shared by all monitor
+// types, merged with the attributes of the given type.
+func monitorAttributes(monitorType string, extra ...map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Options for monitor display name.",
+		},
+		"parent": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for group id.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Describes the monitor.",
+		},
+		"type": schema.StringAttribute{
+			Computed: true,
+			Default:  stringdefault.StaticString(monitorType),
+		},
+		"interval": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for heartbeat Interval. default to `60`.",
+			Default:             int64default.StaticInt64(60),
+		},
+		"retry_interval": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for Retry every second. default to `20`.",
+			Default:             int64default.StaticInt64(20),
+		},
+		"resend_interval": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for resend every times. defaults to `0`",
+			Default:             int64default.StaticInt64(0),
+		},
+		"max_retries": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for maximum retries before the service is marked as down and a notification is sent. default to `5`.",
+			Default:             int64default.StaticInt64(5),
+		},
+		"notification_list": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for notification id list, automatically enable default notifications.",
+			ElementType:         types.Int64Type,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"upside_down": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for Upside Down Mode. Flip the status upside down. If the service is reachable, it is DOWN. defaults to `false`",
+			Default:             booldefault.StaticBool(false),
+		},
+		"tags": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Options for monitor tag",
+			Optional:            true,
+			Computed:            true,
+			Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+		},
+	}
+
+	for _, set := range extra {
+		maps.Copy(attributes, set)
+	}
+
+	return attributes
+}
+
+// httpAttributes returns the request options of the monitors that make HTTP
+// requests.
+func httpAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Options for monitoring url.",
+		},
+		"max_redirects": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for maximum number of redirects to follow. Set to 0 to disable redirects. defaults to `10`",
+			Default:             int64default.StaticInt64(10),
+		},
+		"http_option_method": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for http monitor method. default to `GET`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"),
+			},
+			Default: stringdefault.StaticString("GET"),
+		},
+		"http_option_body_encoding": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for body encoding. default to `none`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("json", "xml"),
+			},
+			Default: stringdefault.StaticString("json"),
+		},
+		"http_option_body": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for body content. default to `none`.",
+			Default:             stringdefault.StaticString(""),
+		},
+		"http_option_headers": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for http headers.",
+			Default:             stringdefault.StaticString(""),
+		},
+		"accepted_statuscodes": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for Accepted Status Codes. Select status codes which are considered as a successful response., defaults to `[\"200-299\"]`",
+			ElementType:         types.StringType,
+			Default: listdefault.StaticValue(
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("200-299")}),
+			),
+		},
+		"expiry_notification": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for Certificate Expiry Notification. defaults to `true`.",
+			Default:             booldefault.StaticBool(true),
+		},
+		"ignore_tls": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Options for ignore TLS/SSL error for HTTPS websites, defaults to `false`.",
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
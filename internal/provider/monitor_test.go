@@ -9,10 +9,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-func setupTest() (*kuma.Monitor, *MonitorResourceModel, diag.Diagnostics) {
+func setupTest() (*kuma.Monitor, *HttpMonitorResourceModel, diag.Diagnostics) {
 	ctx := context.Background()
 
 	plan := kuma.Monitor{
@@ -52,31 +56,33 @@ func setupTest() (*kuma.Monitor, *MonitorResourceModel, diag.Diagnostics) {
 		return nil, nil, err
 	}
 
-	providerPlan := MonitorResourceModel{
-		ID:                  types.Int64Value(plan.ID),
-		Name:                types.StringValue(plan.Name),
-		Description:         types.StringValue(plan.PathName),
-		Parent:              types.Int64Value(plan.Parent),
+	providerPlan := HttpMonitorResourceModel{
+		MonitorBaseModel: MonitorBaseModel{
+			ID:                 types.Int64Value(plan.ID),
+			Name:               types.StringValue(plan.Name),
+			Description:        types.StringValue(plan.PathName),
+			Parent:             types.Int64Value(plan.Parent),
+			Type:               types.StringValue(plan.Type),
+			Interval:           types.Int64Value(plan.Interval),
+			RetryInterval:      types.Int64Value(plan.RetryInterval),
+			ResendInterval:     types.Int64Value(plan.ResendInterval),
+			MaxRetries:         types.Int64Value(plan.MaxRedirects),
+			UpsideDown:         types.BoolValue(plan.UpsideDown),
+			NotificationIDList: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(5)}),
+			Tags:               providerTag,
+		},
 		Url:                 types.StringValue(plan.Url),
 		Method:              types.StringValue(plan.Method),
-		Type:                types.StringValue(plan.Type),
-		Interval:            types.Int64Value(plan.Interval),
 		MaxRedirects:        types.Int64Value(plan.MaxRedirects),
-		RetryInterval:       types.Int64Value(plan.RetryInterval),
-		ResendInterval:      types.Int64Value(plan.ResendInterval),
-		MaxRetries:          types.Int64Value(plan.MaxRedirects),
 		ExpiryNotification:  types.BoolValue(plan.ExpiryNotification),
-		UpsideDown:          types.BoolValue(plan.UpsideDown),
 		IgnoreTls:           types.BoolValue(plan.IgnoreTls),
 		AcceptedStatusCodes: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("200")}),
-		NotificationIDList:  types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(5)}),
-		Tags:                providerTag,
 	}
 	return &plan, &providerPlan, nil
 }
 
 func TestMonitorConvert(t *testing.T) {
-	var providerPlan MonitorResourceModel
+	var providerPlan HttpMonitorResourceModel
 	plan, _, diag := setupTest()
 	if diag != nil {
 		t.Fatal(diag)
@@ -101,3 +107,48 @@ func TestMonitorConvert(t *testing.T) {
 		t.Fatal("failed to convert")
 	}
 }
+
+// testCreate runs Create of r with plan and returns the response.
+func testCreate(t *testing.T, r resource.Resource, plan any) *resource.CreateResponse {
+	t.Helper()
+	return testCreateContext(t, context.Background(), r, plan)
+}
+
+// testCreateContext is testCreate with the context passed to Create, for
+// example one capturing the logs.
+func testCreateContext(t *testing.T, ctx context.Context, r resource.Resource, plan any) *resource.CreateResponse {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema},
+	}
+	if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+		t.Fatalf("unexpected plan diagnostics: %v", diags)
+	}
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	r.Create(ctx, req, resp)
+
+	return resp
+}
+
+// testStateID returns the id stored in state by a resource operation.
+func testStateID(t *testing.T, state tfsdk.State) types.Int64 {
+	t.Helper()
+
+	var id types.Int64
+	if diags := state.GetAttribute(context.Background(), path.Root("id"), &id); diags.HasError() {
+		t.Fatalf("unexpected state diagnostics: %v", diags)
+	}
+
+	return id
+}
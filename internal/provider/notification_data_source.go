@@ -23,7 +23,7 @@ type Notifications struct {
 }
 
 type NotificationsDataSource struct {
-	client *kuma.Client
+	client kuma.API
 }
 
 func (d *NotificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -66,7 +66,7 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var states Notifications
 
-	notifications, err := d.client.GetNotifications()
+	notifications, err := d.client.GetNotifications(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Notifications",
@@ -98,11 +98,11 @@ func (d *NotificationsDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*kuma.Client)
+	client, ok := req.ProviderData.(kuma.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationsDataSource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	ops := server.AddNotification(kuma.Notification{Name: "ops", Type: "slack", Active: true, IsDefault: true})
+	server.AddNotification(kuma.Notification{Name: "oncall", Type: "pagerduty"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "kuma_notifications" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.#", "2"),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.0.id", strconv.FormatInt(ops.ID, 10)),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.0.name", "ops"),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.0.type", "slack"),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.0.active", "true"),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.0.default", "true"),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.1.name", "oncall"),
+					resource.TestCheckResourceAttr("data.kuma_notifications.all", "notifications.1.active", "false"),
+				),
+			},
+		},
+	})
+}
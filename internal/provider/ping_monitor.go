@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PingMonitorResourceModel struct {
+	MonitorBaseModel
+
+	Hostname              types.String `tfsdk:"hostname"`
+	PacketSize            types.Int64  `tfsdk:"packet_size"`
+	PingCount             types.Int64  `tfsdk:"ping_count"`
+	PingPerRequestTimeout types.Int64  `tfsdk:"ping_per_request_timeout"`
+}
+
+func (m *PingMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.MonitorBaseModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.Hostname = m.Hostname.ValueString()
+	monitor.PacketSize = m.PacketSize.ValueInt64()
+	monitor.PingCount = m.PingCount.ValueInt64()
+	monitor.PingPerRequestTimeout = m.PingPerRequestTimeout.ValueInt64()
+
+	return monitor, nil
+}
+
+func (m *PingMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.Hostname = types.StringValue(stu.Hostname)
+	m.PacketSize = types.Int64Value(stu.PacketSize)
+	m.PingCount = types.Int64Value(stu.PingCount)
+	m.PingPerRequestTimeout = types.Int64Value(stu.PingPerRequestTimeout)
+
+	// Uptime Kuma before 2.0 has neither setting and behaves like the defaults.
+	if stu.PingCount == 0 {
+		m.PingCount = types.Int64Value(1)
+	}
+	if stu.PingPerRequestTimeout == 0 {
+		m.PingPerRequestTimeout = types.Int64Value(2)
+	}
+
+	return m.MonitorBaseModel.ConvertFrom(stu)
+}
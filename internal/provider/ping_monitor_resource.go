@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &pingMonitorResource{}
+var _ resource.ResourceWithImportState = &pingMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &pingMonitorResource{}
+
+func NewPingMonitorResource() resource.Resource {
+	return &pingMonitorResource{
+		monitorResource: monitorResource[PingMonitorResourceModel, *PingMonitorResourceModel]{
+			requirements: []serverRequirement{
+				{Path: path.Root("ping_count"), MinVersion: "2.0.0", Feature: "The ping_count attribute"},
+				{Path: path.Root("ping_per_request_timeout"), MinVersion: "2.0.0", Feature: "The ping_per_request_timeout attribute"},
+			},
+		},
+	}
+}
+
+// pingMonitorResource manages monitors that send ICMP echo requests.
+type pingMonitorResource struct {
+	monitorResource[PingMonitorResourceModel, *PingMonitorResourceModel]
+}
+
+func (r *pingMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ping_monitor"
+}
+
+func (r *pingMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a monitor that is up when the host answers ICMP echo requests.",
+
+		Attributes: monitorAttributes("ping", map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Hostname or IP address to ping.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"packet_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Size of the echo request payload in bytes. defaults to `56`.",
+				Default:             int64default.StaticInt64(56),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65500),
+				},
+			},
+			"ping_count": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of echo requests sent per check. Defaults to `1`. Setting another value requires Uptime Kuma 2.0.",
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"ping_per_request_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Seconds to wait for each echo reply. Defaults to `2`. Setting another value requires Uptime Kuma 2.0.",
+				Default:             int64default.StaticInt64(2),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 60),
+				},
+			},
+		}),
+	}
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccPingMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.Version = "2.0.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_ping_monitor" "test" {
+  name     = "router"
+  hostname = "10.0.0.1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "type", "ping"),
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "hostname", "10.0.0.1"),
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "packet_size", "56"),
+					// Filled in by the server.
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "ping_count", "1"),
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "ping_per_request_timeout", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_ping_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_ping_monitor" "test" {
+  name                     = "router"
+  hostname                 = "10.0.0.1"
+  packet_size              = 1400
+  ping_count               = 5
+  ping_per_request_timeout = 3
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_ping_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "packet_size", "1400"),
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "ping_count", "5"),
+					testAccCheckMonitor(server, "kuma_ping_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.PacketSize != 1400 || monitor.PingCount != 5 || monitor.PingPerRequestTimeout != 3 {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// Removing the settings restores the defaults.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_ping_monitor" "test" {
+  name        = "router"
+  hostname    = "10.0.0.1"
+  packet_size = 1400
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_ping_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "ping_count", "1"),
+					resource.TestCheckResourceAttr("kuma_ping_monitor.test", "ping_per_request_timeout", "2"),
+					testAccCheckMonitor(server, "kuma_ping_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.PingCount != 1 || monitor.PingPerRequestTimeout != 2 {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPingMonitorResourceCountUnsupported(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_ping_monitor" "test" {
+  name       = "router"
+  hostname   = "10.0.0.1"
+  ping_count = 3
+}
+`,
+				ExpectError: regexp.MustCompile(`The ping_count attribute requires Uptime Kuma 2\.0\.0 or later, the server runs\s+1\.23\.16`),
+			},
+		},
+	})
+}
@@ -2,22 +2,30 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"terraform-provider-kuma/internal/kuma"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/spf13/viper"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &kumaProvider{}
+	_ provider.Provider                     = &kumaProvider{}
+	_ provider.ProviderWithConfigValidators = &kumaProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -30,15 +38,38 @@ func New(version string) func() provider.Provider {
 }
 
 type kumaProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Host         types.String `tfsdk:"host"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Token        types.String `tfsdk:"token"`
+	TOTPSecret   types.String `tfsdk:"totp_secret"`
+	TOTPToken    types.String `tfsdk:"totp_token"`
+	Backend      types.String `tfsdk:"backend"`
+	ConfigFile   types.String `tfsdk:"config_file"`
+	Profile      types.String `tfsdk:"profile"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
 }
 
 type KumaConfiguration struct {
-	Host     string
-	Username string
-	Password string
+	Host       string
+	Username   string
+	Password   string
+	Token      string
+	TOTPSecret string `mapstructure:"totp_secret"`
+	Backend    string
 }
 
 // Uptime KumaProvider is the provider implementation.
@@ -72,10 +103,146 @@ func (p *kumaProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"token": schema.StringAttribute{
+				Description: "Pre-issued bearer token for Uptime Kuma API, used instead of username and password. May also be provided via KUMA_API_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"totp_secret": schema.StringAttribute{
+				Description: "Base32 secret of the two-factor authentication enabled on the Uptime Kuma account, used to generate a code at sign-in. May also be provided via KUMA_API_TOTP_SECRET environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"totp_token": schema.StringAttribute{
+				Description: "One-time two-factor authentication code sent at sign-in. Prefer `totp_secret`, as a fixed code cannot be reused when the provider needs to sign in again.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"config_file": schema.StringAttribute{
+				Description: "Path to the YAML config file holding connection settings. Defaults to `~/.config/kuma/config.yaml`, which is skipped when it does not exist. May also be provided via KUMA_CONFIG_FILE environment variable.",
+				Optional:    true,
+			},
+			"backend": schema.StringAttribute{
+				Description: "API used to manage Uptime Kuma: `rest` for the Uptime Kuma REST API wrapper, or `socketio` for the Socket.IO API of Uptime Kuma itself, which needs no wrapper. " +
+					"With `socketio`, `host` is the URL of Uptime Kuma and `token` a token issued by Uptime Kuma at sign-in. Defaults to `rest`. May also be provided via KUMA_BACKEND environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(kuma.BackendREST), string(kuma.BackendSocketIO)),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the entry under `profiles:` in the config file to read connection settings from. Without it, settings are read from the top level of the file. May also be provided via KUMA_PROFILE environment variable.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for requests failing with a connection error or a 429, 502, 503 or 504 status. Defaults to `4`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				Description: "Minimum time in seconds to wait before retrying a request. Defaults to `1`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait before retrying a request. A `Retry-After` header sent by the server takes precedence. Defaults to `30`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests sent to the Uptime Kuma API at the same time, regardless of Terraform parallelism. Defaults to `0`, which means unlimited.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum average number of requests per second sent to the Uptime Kuma API. Defaults to `0`, which means unlimited.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates used to verify the Uptime Kuma API server instead of the system roots.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file with CA certificates used to verify the Uptime Kuma API server instead of the system roots.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM-encoded client certificate for mutual TLS. Requires `client_key`.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key of the client certificate for mutual TLS. Requires `client_cert`.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the Uptime Kuma API server certificate. Defaults to `false`.",
+				Optional:    true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds of a single request to the Uptime Kuma API. Defaults to `300`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to reach the Uptime Kuma API. Defaults to the proxy set by the HTTP_PROXY and HTTPS_PROXY environment variables.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+// ConfigValidators rejects configurations mixing token and username/password authentication.
+func (p *kumaProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("token"),
+			path.MatchRoot("username"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("token"),
+			path.MatchRoot("password"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("username"),
+			path.MatchRoot("password"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("totp_secret"),
+			path.MatchRoot("totp_token"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("token"),
+			path.MatchRoot("totp_secret"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("token"),
+			path.MatchRoot("totp_token"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_pem"),
+			path.MatchRoot("ca_cert_file"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_cert"),
+			path.MatchRoot("client_key"),
+		),
+	}
+}
+
 func (p *kumaProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Retrieve provider data from configuration
 	var config kumaProviderModel
@@ -88,35 +255,33 @@ func (p *kumaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	var c struct {
-		Host     string
-		Username string
-		Password string
-	}
+	configFile := os.Getenv("KUMA_CONFIG_FILE")
+	profile := os.Getenv("KUMA_PROFILE")
 
-	viper.SetConfigName("config")
-	viper.SetConfigType("yaml")
-	viper.AddConfigPath("$HOME/.config/kuma/")
+	if !config.ConfigFile.IsNull() {
+		configFile = config.ConfigFile.ValueString()
+	}
 
-	if err := viper.ReadInConfig(); err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root(""),
-			"Unknown Uptime Kuma API Host",
-			"The provdier can read config file from home path. "+err.Error(),
-		)
+	if !config.Profile.IsNull() {
+		profile = config.Profile.ValueString()
 	}
 
-	if err := viper.Unmarshal(&c); err != nil {
+	c, err := loadConfigFile(configFile, profile)
+	if err != nil {
 		resp.Diagnostics.AddAttributeError(
-			path.Root(""),
-			"Unknown Uptime Kuma API Host",
-			"The provdier can marshal config file from home path. "+err.Error(),
+			path.Root("config_file"),
+			"Unable to Read Uptime Kuma Config File",
+			"The provider cannot read connection settings from the config file. "+err.Error(),
 		)
+		return
 	}
 
 	host := os.Getenv("KUMA_API_HOST")
 	username := os.Getenv("KUMA_API_USERNAME")
 	password := os.Getenv("KUMA_API_PASSWORD")
+	token := os.Getenv("KUMA_API_TOKEN")
+	totpSecret := os.Getenv("KUMA_API_TOTP_SECRET")
+	backend := os.Getenv("KUMA_BACKEND")
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -143,6 +308,38 @@ func (p *kumaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		password = c.Password
 	}
 
+	if !config.Token.IsNull() {
+		token = config.Token.ValueString()
+	} else if token == "" {
+		token = c.Token
+	}
+
+	if !config.TOTPSecret.IsNull() {
+		totpSecret = config.TOTPSecret.ValueString()
+	} else if totpSecret == "" {
+		totpSecret = c.TOTPSecret
+	}
+
+	if !config.Backend.IsNull() {
+		backend = config.Backend.ValueString()
+	} else if backend == "" {
+		backend = c.Backend
+	}
+
+	totpToken := config.TOTPToken.ValueString()
+	if totpToken != "" {
+		totpSecret = ""
+	}
+
+	// An authentication mode chosen in the Terraform configuration wins
+	// over credentials picked up from the environment or config file.
+	switch {
+	case !config.Token.IsNull():
+		username, password = "", ""
+	case !config.Username.IsNull() || !config.Password.IsNull():
+		token = ""
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -156,39 +353,137 @@ func (p *kumaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
-	if username == "" {
+	if token != "" && (username != "" || password != "") {
+		resp.Diagnostics.AddError(
+			"Conflicting Uptime Kuma API Authentication",
+			"The provider cannot decide how to authenticate as both a token and username/password were found. "+
+				"Configure either the token (KUMA_API_TOKEN) or the username and password (KUMA_API_USERNAME, KUMA_API_PASSWORD), not both.",
+		)
+	}
+
+	if token == "" && username == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing Uptime Kuma API Username",
 			"The provider cannot create the Uptime Kuma API client as there is a missing or empty value for the Uptime Kuma API username. "+
-				"Set the username value in the configuration or use the Uptime KUMA_API_USERNAME environment variable. "+
+				"Set the username value in the configuration or use the Uptime KUMA_API_USERNAME environment variable, "+
+				"or authenticate with a token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if password == "" {
+	if token == "" && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing Uptime Kuma API Password",
 			"The provider cannot create the Uptime Kuma API client as there is a missing or empty value for the Uptime Kuma API password. "+
-				"Set the password value in the configuration or use the Uptime KUMA_API_PASSWORD environment variable. "+
+				"Set the password value in the configuration or use the Uptime KUMA_API_PASSWORD environment variable, "+
+				"or authenticate with a token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	switch kuma.Backend(backend) {
+	case "", kuma.BackendREST, kuma.BackendSocketIO:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backend"),
+			"Invalid Uptime Kuma API Backend",
+			fmt.Sprintf("The backend value %q must be either %q or %q.", backend, kuma.BackendREST, kuma.BackendSocketIO),
+		)
+	}
+
+	retryMax := int64(kuma.DefaultRetryMax)
+	retryMinWait := kuma.DefaultRetryWaitMin
+	retryMaxWait := kuma.DefaultRetryWaitMax
+
+	if !config.MaxRetries.IsNull() {
+		retryMax = config.MaxRetries.ValueInt64()
+	}
+
+	if !config.RetryMinWait.IsNull() {
+		retryMinWait = time.Duration(config.RetryMinWait.ValueInt64()) * time.Second
+	}
+
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid Uptime Kuma API Retry Wait",
+			fmt.Sprintf("The retry_min_wait value (%s) must not be greater than retry_max_wait (%s).", retryMinWait, retryMaxWait),
+		)
+	}
+
+	transport := kuma.TransportConfig{
+		CACertPEM:          config.CACertPEM.ValueString(),
+		ClientCertPEM:      config.ClientCert.ValueString(),
+		ClientKeyPEM:       config.ClientKey.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           config.ProxyURL.ValueString(),
+		Timeout:            time.Duration(config.RequestTimeout.ValueInt64()) * time.Second,
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCert, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read Uptime Kuma API CA Certificate",
+				"The provider cannot read the CA certificate file. "+err.Error(),
+			)
+		}
+		transport.CACertPEM = string(caCert)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "kuma_api_host", host)
+	ctx = tflog.SetField(ctx, "kuma_api_backend", backend)
 	ctx = tflog.SetField(ctx, "kuma_api_username", username)
 	ctx = tflog.SetField(ctx, "kuma_api_password", password)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "kuma_api_password")
+	ctx = tflog.SetField(ctx, "kuma_api_token", token)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "kuma_api_password", "kuma_api_token")
+	ctx = tflog.SetField(ctx, "kuma_api_totp", totpSecret != "" || totpToken != "")
 
 	tflog.Debug(ctx, "Creating Kuma API client")
 
+	opts := []kuma.ClientOption{
+		kuma.WithRetry(int(retryMax), retryMinWait, retryMaxWait),
+		kuma.WithTransport(transport),
+		kuma.WithConcurrencyLimit(int(config.MaxConcurrentRequests.ValueInt64())),
+		kuma.WithRateLimit(config.RequestsPerSecond.ValueFloat64()),
+		kuma.WithBackend(kuma.Backend(backend)),
+	}
+
+	// Token authentication skips signing in, which only happens when both
+	// username and password are passed to the client.
+	usernamePtr, passwordPtr := &username, &password
+	if token != "" {
+		usernamePtr, passwordPtr = nil, nil
+		opts = append(opts, kuma.WithToken(token))
+	}
+
+	if totpSecret != "" || totpToken != "" {
+		opts = append(opts, kuma.WithTOTP(totpSecret, totpToken))
+	}
+
 	// Create a new Uptime Kuma client using the configuration values
-	client, err := kuma.NewClient(&host, &username, &password)
+	client, err := kuma.NewClient(ctx, &host, usernamePtr, passwordPtr, opts...)
+	if errors.Is(err, kuma.ErrTOTPRequired) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("totp_secret"),
+			"Missing Uptime Kuma Two-Factor Authentication",
+			"The Uptime Kuma account has two-factor authentication enabled, but no code was supplied. "+
+				"Set the totp_secret value in the configuration or use the KUMA_API_TOTP_SECRET environment variable, "+
+				"or pass a current code with totp_token.",
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Uptime Kuma API Client",
@@ -199,6 +494,19 @@ func (p *kumaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// Resources check the version before planning features the server may
+	// not support. An unknown version only disables those checks.
+	info, err := client.GetServerInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Detect Uptime Kuma Version",
+			"The provider could not read the Uptime Kuma server version, so it cannot warn about features the server does not support.\n\n"+
+				"Uptime Kuma Client Error: "+err.Error(),
+		)
+	} else {
+		ctx = tflog.SetField(ctx, "kuma_server_version", info.Version)
+	}
+
 	// Make the Uptime Kuma client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -213,6 +521,7 @@ func (p *kumaProvider) DataSources(_ context.Context) []func() datasource.DataSo
 		NewTagsDataSource,
 		NewMonitorsDataSource,
 		NewNotificationsDataSource,
+		NewServerInfoDataSource,
 	}
 }
 
@@ -222,5 +531,19 @@ func (p *kumaProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewTagResource,
 		NewHttpMonitorResource,
 		NewGroupResource,
+		NewKeywordMonitorResource,
+		NewJsonQueryMonitorResource,
+		NewTcpPortMonitorResource,
+		NewPingMonitorResource,
+		NewDnsMonitorResource,
+		NewDockerHostResource,
+		NewDockerContainerMonitorResource,
+		NewPushMonitorResource,
+		NewPostgresMonitorResource,
+		NewMysqlMonitorResource,
+		NewSqlServerMonitorResource,
+		NewMongoDBMonitorResource,
+		NewRedisMonitorResource,
+		NewGrpcKeywordMonitorResource,
 	}
 }
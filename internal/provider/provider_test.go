@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"kuma": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck isolates the provider from the configuration of the
+// machine running the tests.
+func testAccPreCheck(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("KUMA_API_HOST", "")
+	t.Setenv("KUMA_API_USERNAME", "")
+	t.Setenv("KUMA_API_PASSWORD", "")
+	t.Setenv("KUMA_API_TOKEN", "")
+	t.Setenv("KUMA_API_TOTP_SECRET", "")
+	t.Setenv("KUMA_CONFIG_FILE", "")
+	t.Setenv("KUMA_PROFILE", "")
+	t.Setenv("KUMA_BACKEND", "")
+}
+
+// testAccProviderConfig points the provider at a fake Kuma server.
+func testAccProviderConfig(server *kumatest.Server) string {
+	return fmt.Sprintf(`
+provider "kuma" {
+  host        = %q
+  username    = %q
+  password    = %q
+  max_retries = 0
+}
+`, server.URL, server.Username, server.Password)
+}
+
+// testAccSocketIOProviderConfig points the provider at the Socket.IO API of a
+// fake Kuma server.
+func testAccSocketIOProviderConfig(server *kumatest.Server) string {
+	return fmt.Sprintf(`
+provider "kuma" {
+  host        = %q
+  username    = %q
+  password    = %q
+  backend     = "socketio"
+  max_retries = 0
+}
+`, server.URL, server.Username, server.Password)
+}
+
+func TestAccProviderSocketIOBackend(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.AddNotification(kuma.Notification{Name: "ops", Type: "slack", Active: true, IsDefault: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSocketIOProviderConfig(server) + `
+resource "kuma_tag" "env" {
+  name  = "env"
+  color = "#2563EB"
+}
+
+resource "kuma_http_monitor" "test" {
+  name             = "example"
+  description      = "public site"
+  url              = "https://example.com"
+  upside_down      = true
+  ignore_tls       = true
+  http_option_body = "{}"
+
+  tags = {
+    (kuma_tag.env.name) = "prod"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "upside_down", "true"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "notification_list.#", "1"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.env", "prod"),
+					testAccCheckMonitorExists(server, "kuma_http_monitor.test"),
+				),
+			},
+			{
+				ResourceName:      "kuma_http_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSocketIOProviderConfig(server) + `
+resource "kuma_tag" "env" {
+  name  = "env"
+  color = "#2563EB"
+}
+
+resource "kuma_http_monitor" "test" {
+  name              = "example"
+  url               = "https://example.org"
+  notification_list = []
+
+  tags = {
+    (kuma_tag.env.name) = "staging"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_http_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "url", "https://example.org"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "tags.env", "staging"),
+					// Zero values are sent too, they turn settings off.
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "description", ""),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "upside_down", "false"),
+					resource.TestCheckResourceAttr("kuma_http_monitor.test", "notification_list.#", "0"),
+					testAccCheckMonitor(server, "kuma_http_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.UpsideDown || monitor.IgnoreTls || monitor.Description != "" || monitor.Body != "" || len(monitor.NotificationIDList) != 0 {
+							return fmt.Errorf("expected the settings to be cleared, got %+v", monitor)
+						}
+						return nil
+					}),
+					testAccCheckEditedOverSocketIO(server),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckEditedOverSocketIO verifies the monitor update went through the Socket.IO API.
+func testAccCheckEditedOverSocketIO(server *kumatest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if events := server.SocketEvents(); !slices.Contains(events, "editMonitor") {
+			return fmt.Errorf("expected the monitor to be edited over Socket.IO, got events %v", events)
+		}
+		return nil
+	}
+}
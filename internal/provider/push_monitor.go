@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pushTokenLength and pushTokenAlphabet match the tokens the Kuma UI
+// generates.
+const (
+	pushTokenLength   = 32
+	pushTokenAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+type PushMonitorResourceModel struct {
+	MonitorBaseModel
+
+	PushToken types.String `tfsdk:"push_token"`
+	PushURL   types.String `tfsdk:"push_url"`
+}
+
+func (m *PushMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, diags := m.MonitorBaseModel.Convert()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	monitor.PushToken = m.PushToken.ValueString()
+
+	// The token is generated on create, UseStateForUnknown keeps it
+	// afterwards.
+	if m.PushToken.IsNull() || m.PushToken.IsUnknown() {
+		token, err := generatePushToken()
+		if err != nil {
+			diags.AddError("Unable to Generate Push Token", err.Error())
+			return nil, diags
+		}
+		monitor.PushToken = token
+	}
+
+	return monitor, nil
+}
+
+func (m *PushMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.PushToken = types.StringValue(stu.PushToken)
+
+	return m.MonitorBaseModel.ConvertFrom(stu)
+}
+
+func (m *PushMonitorResourceModel) derive(ctx context.Context, client kuma.API) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pushURL, err := client.PushURL(ctx, m.PushToken.ValueString())
+	if err != nil {
+		m.PushURL = types.StringNull()
+		diags.AddAttributeWarning(
+			path.Root("push_url"),
+			"Unknown Push URL",
+			"Could not compute the push URL, set the Primary Base URL in the Uptime Kuma settings: "+err.Error(),
+		)
+		return diags
+	}
+
+	m.PushURL = types.StringValue(pushURL)
+
+	return diags
+}
+
+// generatePushToken returns a cryptographically random push token.
+func generatePushToken() (string, error) {
+	token := make([]byte, pushTokenLength)
+	alphabet := big.NewInt(int64(len(pushTokenAlphabet)))
+
+	for i := range token {
+		n, err := rand.Int(rand.Reader, alphabet)
+		if err != nil {
+			return "", err
+		}
+		token[i] = pushTokenAlphabet[n.Int64()]
+	}
+
+	return string(token), nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &pushMonitorResource{}
+var _ resource.ResourceWithImportState = &pushMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &pushMonitorResource{}
+
+func NewPushMonitorResource() resource.Resource {
+	return &pushMonitorResource{}
+}
+
+// pushMonitorResource manages monitors that wait for heartbeats pushed to
+// them.
+type pushMonitorResource struct {
+	monitorResource[PushMonitorResourceModel, *PushMonitorResourceModel]
+}
+
+func (r *pushMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_push_monitor"
+}
+
+func (r *pushMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a monitor that is up while heartbeats are pushed to its URL, for example by a batch job.",
+
+		Attributes: monitorAttributes("push", map[string]schema.Attribute{
+			"push_token": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token identifying the monitor in its push URL. A random token is generated when none is given, and kept until another one is set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_-]+$`), "must only contain letters, digits, underscores and dashes"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"push_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "URL to push heartbeats to, based on the primary base URL of the server. Without one it is null, unless the `socketio` backend is used, in which case it is based on the provider `host`. The query reports the service as up and can be changed, see the Kuma UI for the parameters.",
+			},
+		}),
+	}
+}
+
+// ModifyPlan plans the push URL when the token is known, so that it can be
+// used before the monitor is created.
+func (r *pushMonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.monitorResource.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var token types.String
+
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("push_token"), &token)...)
+	if resp.Diagnostics.HasError() || token.IsUnknown() || token.IsNull() {
+		return
+	}
+
+	// Without a primary base URL the push URL stays unknown, the warning is
+	// reported once the monitor is read.
+	pushURL, err := r.client.PushURL(ctx, token.ValueString())
+	if err != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("push_url"), pushURL)...)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumamock"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccPushMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+
+	pushURL := func(token string) string {
+		return server.URL + "/api/push/" + token + "?status=up&msg=OK&ping="
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_push_monitor" "test" {
+  name       = "backup"
+  push_token = "not a token"
+}
+`,
+				ExpectError: regexp.MustCompile(`must only contain letters, digits, underscores and\s+dashes`),
+			},
+			// Create with a generated token
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_push_monitor" "test" {
+  name     = "backup"
+  interval = 3600
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_push_monitor.test", "type", "push"),
+					resource.TestMatchResourceAttr("kuma_push_monitor.test", "push_token", regexp.MustCompile(`^[A-Za-z0-9]{32}$`)),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["kuma_push_monitor.test"].Primary.Attributes
+						if want := pushURL(attributes["push_token"]); attributes["push_url"] != want {
+							return fmt.Errorf("expected push_url %q, got %q", want, attributes["push_url"])
+						}
+						return nil
+					},
+					testAccCheckMonitor(server, "kuma_push_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Type != "push" || len(monitor.PushToken) != 32 {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_push_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A given token replaces the generated one, and the URL is known
+			// when planning.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_push_monitor" "test" {
+  name       = "backup"
+  interval   = 3600
+  push_token = "nightly-backup"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_push_monitor.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("kuma_push_monitor.test", tfjsonpath.New("push_url"), knownvalue.StringExact(pushURL("nightly-backup"))),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_push_monitor.test", "push_token", "nightly-backup"),
+					resource.TestCheckResourceAttr("kuma_push_monitor.test", "push_url", pushURL("nightly-backup")),
+				),
+			},
+			// Removing the token from the configuration keeps it.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_push_monitor" "test" {
+  name     = "backup"
+  interval = 3600
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("kuma_push_monitor.test", "push_token", "nightly-backup"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPushMonitorResourceNoPrimaryBaseURL(t *testing.T) {
+	server := kumatest.NewServer(t)
+	// The REST wrapper's address is not where Kuma receives pushes.
+	server.PrimaryBaseURL = ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_push_monitor" "test" {
+  name       = "backup"
+  push_token = "nightly-backup"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_push_monitor.test", "push_token", "nightly-backup"),
+					resource.TestCheckNoResourceAttr("kuma_push_monitor.test", "push_url"),
+				),
+			},
+			// The missing URL does not show up as a change.
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_push_monitor" "test" {
+  name       = "backup"
+  push_token = "nightly-backup"
+}
+`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestPushMonitorResourceCreateLogs(t *testing.T) {
+	client := kumamock.NewMockAPI(gomock.NewController(t))
+	monitorID := int64(42)
+
+	var created kuma.Monitor
+	client.EXPECT().GetDefaultNotifications(gomock.Any()).Return(nil, nil)
+	client.EXPECT().CreateMonitor(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, monitor kuma.Monitor) (*int64, error) {
+		created = monitor
+		return &monitorID, nil
+	})
+	client.EXPECT().GetMonitor(gomock.Any(), monitorID).DoAndReturn(func(context.Context, int64) (*kuma.Monitor, error) {
+		created.ID = monitorID
+		return &created, nil
+	})
+	client.EXPECT().PushURL(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token string) (string, error) {
+		return "https://kuma.example.com/api/push/" + token, nil
+	})
+
+	r := &pushMonitorResource{}
+	r.client = client
+
+	var logs bytes.Buffer
+	resp := testCreateContext(t, tflogtest.RootLogger(context.Background(), &logs), r, &PushMonitorResourceModel{
+		MonitorBaseModel: MonitorBaseModel{
+			ID:                 types.Int64Unknown(),
+			Name:               types.StringValue("backup"),
+			Type:               types.StringValue("push"),
+			NotificationIDList: types.ListNull(types.Int64Type),
+			Tags:               types.MapNull(types.StringType),
+		},
+		PushToken: types.StringUnknown(),
+		PushURL:   types.StringUnknown(),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if created.PushToken == "" {
+		t.Fatal("expected a generated push token")
+	}
+	if strings.Contains(logs.String(), created.PushToken) {
+		t.Fatalf("the push token was logged:\n%s", logs.String())
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ServerInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &ServerInfoDataSource{}
+)
+
+func NewServerInfoDataSource() datasource.DataSource {
+	return &ServerInfoDataSource{}
+}
+
+type ServerInfo struct {
+	Version              types.String `tfsdk:"version"`
+	LatestVersion        types.String `tfsdk:"latest_version"`
+	PrimaryBaseURL       types.String `tfsdk:"primary_base_url"`
+	ServerTimezone       types.String `tfsdk:"server_timezone"`
+	ServerTimezoneOffset types.String `tfsdk:"server_timezone_offset"`
+}
+
+type ServerInfoDataSource struct {
+	client kuma.API
+}
+
+func (d *ServerInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *ServerInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Describes the Uptime Kuma server the provider is connected to.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Uptime Kuma version the server runs.",
+				Computed:            true,
+			},
+			"latest_version": schema.StringAttribute{
+				MarkdownDescription: "Latest Uptime Kuma release known to the server. Empty when update checks are disabled.",
+				Computed:            true,
+			},
+			"primary_base_url": schema.StringAttribute{
+				MarkdownDescription: "Primary base URL configured in the server settings.",
+				Computed:            true,
+			},
+			"server_timezone": schema.StringAttribute{
+				MarkdownDescription: "Timezone of the server, for example `Europe/Berlin`.",
+				Computed:            true,
+			},
+			"server_timezone_offset": schema.StringAttribute{
+				MarkdownDescription: "UTC offset of the server timezone, for example `+02:00`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ServerInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := d.client.GetServerInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Server Info",
+			err.Error(),
+		)
+		return
+	}
+
+	state := ServerInfo{
+		Version:              types.StringValue(info.Version),
+		LatestVersion:        types.StringValue(info.LatestVersion),
+		PrimaryBaseURL:       types.StringValue(info.PrimaryBaseURL),
+		ServerTimezone:       types.StringValue(info.ServerTimezone),
+		ServerTimezoneOffset: types.StringValue(info.ServerTimezoneOffset),
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServerInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(kuma.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerInfoDataSource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.Version = "2.0.0-beta.2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "kuma_server_info" "current" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_server_info.current", "version", "2.0.0-beta.2"),
+					resource.TestCheckResourceAttr("data.kuma_server_info.current", "primary_base_url", server.URL),
+					resource.TestCheckResourceAttr("data.kuma_server_info.current", "server_timezone", "UTC"),
+					resource.TestCheckResourceAttr("data.kuma_server_info.current", "server_timezone_offset", "+00:00"),
+				),
+			},
+		},
+	})
+}
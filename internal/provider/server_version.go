@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// serverRequirement is the Uptime Kuma version a resource, or one of its
+// attributes, needs.
+type serverRequirement struct {
+	// Path is the attribute that needs the version. It is empty when the
+	// resource as a whole does.
+	Path       path.Path
+	MinVersion string
+	Feature    string
+}
+
+// checkServerRequirements fails the plan when the configuration uses
+// something the connected server does not support. Attribute requirements
+// only apply when the attribute is set. Nothing is checked when the version
+// is unknown, the provider warned about that when it was configured.
+func checkServerRequirements(ctx context.Context, client kuma.API, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, reqs []serverRequirement) {
+	// Nothing to check when destroying, or before the provider is configured.
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	info, err := client.GetServerInfo(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Skipping server version checks", map[string]any{"error": err.Error()})
+		return
+	}
+
+	for _, requirement := range reqs {
+		if info.AtLeast(requirement.MinVersion) {
+			continue
+		}
+
+		detail := fmt.Sprintf("%s requires Uptime Kuma %s or later, the server runs %s.", requirement.Feature, requirement.MinVersion, info.Version)
+
+		if len(requirement.Path.Steps()) == 0 {
+			resp.Diagnostics.AddError("Unsupported Uptime Kuma Version", detail)
+			continue
+		}
+
+		var value attr.Value
+
+		diags := req.Config.GetAttribute(ctx, requirement.Path, &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || value == nil || value.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(requirement.Path, "Unsupported Uptime Kuma Version", detail)
+	}
+}
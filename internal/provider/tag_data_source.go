@@ -24,7 +24,7 @@ type tagsDataSourceModel struct {
 }
 
 type tagsDataSource struct {
-	client *kuma.Client
+	client kuma.API
 }
 
 func (d *tagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -58,7 +58,7 @@ func (d *tagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 func (d *tagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state tagsDataSourceModel
 
-	tags, err := d.client.GetTags()
+	tags, err := d.client.GetTags(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tags",
@@ -92,11 +92,11 @@ func (d *tagsDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*kuma.Client)
+	client, ok := req.ProviderData.(kuma.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
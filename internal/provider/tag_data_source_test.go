@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagsDataSource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	env := server.AddTag(kuma.Tag{Name: "env", Color: "#2563EB"})
+	server.AddTag(kuma.Tag{Name: "team", Color: "#059669"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "kuma_tags" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_tags.all", "tags.#", "2"),
+					resource.TestCheckResourceAttr("data.kuma_tags.all", "tags.0.id", strconv.FormatInt(env.ID, 10)),
+					resource.TestCheckResourceAttr("data.kuma_tags.all", "tags.0.name", "env"),
+					resource.TestCheckResourceAttr("data.kuma_tags.all", "tags.0.color", "#2563EB"),
+					resource.TestCheckResourceAttr("data.kuma_tags.all", "tags.1.name", "team"),
+				),
+			},
+		},
+	})
+}
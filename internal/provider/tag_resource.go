@@ -3,19 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"terraform-provider-kuma/internal/kuma"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &tagResource{}
-	_ resource.ResourceWithConfigure   = &tagResource{}
-	_ resource.ResourceWithImportState = &tagResource{}
+	_ resource.Resource                 = &tagResource{}
+	_ resource.ResourceWithConfigure    = &tagResource{}
+	_ resource.ResourceWithImportState  = &tagResource{}
+	_ resource.ResourceWithUpgradeState = &tagResource{}
 )
 
 // NewtagResource is a helper function to simplify the provider implementation.
@@ -24,7 +28,7 @@ func NewTagResource() resource.Resource {
 }
 
 type tagResource struct {
-	client *kuma.Client
+	client kuma.API
 }
 
 // Metadata returns the resource type name.
@@ -36,9 +40,14 @@ func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest,
 func (r *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	resp.Schema = schema.Schema{
+		// Version 1 changed id from a string to a number.
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
+			"id": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required: true,
@@ -50,6 +59,59 @@ func (r *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	}
 }
 
+// UpgradeState upgrades the state of tags created before id was a number.
+func (r *tagResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"color": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					ID    types.String `tfsdk:"id"`
+					Name  types.String `tfsdk:"name"`
+					Color types.String `tfsdk:"color"`
+				}
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := Tag{
+					ID:    types.Int64Null(),
+					Name:  prior.Name,
+					Color: prior.Color,
+				}
+				if !prior.ID.IsNull() && !prior.ID.IsUnknown() {
+					id, err := strconv.ParseInt(prior.ID.ValueString(), 10, 64)
+					if err != nil {
+						resp.Diagnostics.AddAttributeError(
+							path.Root("id"),
+							"Invalid Tag ID",
+							fmt.Sprintf("Could not upgrade the state of Kuma Tag %s, ID %q is not a number: %s", prior.Name.ValueString(), prior.ID.ValueString(), err.Error()),
+						)
+						return
+					}
+					state.ID = types.Int64Value(id)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan Tag
@@ -63,7 +125,7 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	item := plan.Convert()
 
 	// Create new tag
-	tag, err := r.client.CreateTag(*item)
+	tag, err := r.client.CreateTag(ctx, *item)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating tag",
@@ -93,7 +155,10 @@ func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	tag, err := r.client.GetTag(state.Name.ValueString())
+	tag, err := r.client.GetTag(ctx, state.Name.ValueString())
+	if removeIfNotFound(ctx, err, resp) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Kuma Tag",
@@ -116,7 +181,7 @@ func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, outputPlan Tag
+	var plan Tag
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -127,7 +192,7 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	item := plan.Convert()
 
 	// Update existing tag
-	err := r.client.UpdateTag(plan.ID.ValueInt64(), *item)
+	err := r.client.UpdateTag(ctx, plan.ID.ValueInt64(), *item)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating tag",
@@ -137,7 +202,7 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	// Fetch updated tag
-	updatedTag, err := r.client.GetTag(plan.Name.ValueString())
+	updatedTag, err := r.client.GetTag(ctx, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Kuma Tag",
@@ -150,7 +215,7 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	plan.ConvertFrom(*updatedTag)
 
 	// Set refreshed state
-	diags = resp.State.Set(ctx, outputPlan)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -167,7 +232,7 @@ func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Delete existing order
-	err := r.client.DeleteTag(state.ID.ValueInt64())
+	err := r.client.DeleteTag(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Kuma Tag",
@@ -182,12 +247,12 @@ func (r *tagResource) Configure(_ context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	client, ok := req.ProviderData.(*kuma.Client)
+	client, ok := req.ProviderData.(kuma.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *hashicups.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected kuma.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
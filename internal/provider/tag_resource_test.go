@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTagResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	client := server.NewClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			tags, err := client.GetTags(context.Background())
+			if err != nil {
+				return err
+			}
+			if len(tags) > 0 {
+				return fmt.Errorf("expected all tags to be destroyed, found %d", len(tags))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_tag" "test" {
+  name  = "env"
+  color = "#2563EB"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_tag.test", "name", "env"),
+					resource.TestCheckResourceAttr("kuma_tag.test", "color", "#2563EB"),
+					resource.TestCheckResourceAttrSet("kuma_tag.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "kuma_tag.test",
+				ImportState:                          true,
+				ImportStateId:                        "env",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_tag" "test" {
+  name  = "env"
+  color = "#059669"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_tag.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("kuma_tag.test", "color", "#059669"),
+			},
+			// Drift: the tag is recolored in the UI and gets reverted.
+			{
+				PreConfig: func() {
+					tag, err := client.GetTag(context.Background(), "env")
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := client.UpdateTag(context.Background(), tag.ID, kuma.Tag{Name: "env", Color: "#DC2626"}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProviderConfig(server) + `
+resource "kuma_tag" "test" {
+  name  = "env"
+  color = "#059669"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_tag.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("kuma_tag.test", "color", "#059669"),
+			},
+			// Drift: the tag is deleted in the UI and gets recreated.
+			{
+				PreConfig: func() {
+					tag, err := client.GetTag(context.Background(), "env")
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := client.DeleteTag(context.Background(), tag.ID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProviderConfig(server) + `
+resource "kuma_tag" "test" {
+  name  = "env"
+  color = "#059669"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_tag.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrSet("kuma_tag.test", "id"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestTagResourceUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Version 0 stored the id as a string.
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "kuma_tag",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"7","name":"env","color":"#2563EB"}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics[0])
+	}
+
+	schema, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := resp.UpgradedState.Unmarshal(schema.ResourceSchemas["kuma_tag"].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	var id *big.Float
+	if err := attributes["id"].As(&id); err != nil {
+		t.Fatal(err)
+	}
+	if id == nil || id.Cmp(big.NewFloat(7)) != 0 {
+		t.Fatalf("expected id 7, got %v", id)
+	}
+}
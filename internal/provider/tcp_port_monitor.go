@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"terraform-provider-kuma/internal/kuma"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TcpPortMonitorResourceModel struct {
+	MonitorBaseModel
+
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+}
+
+func (m *TcpPortMonitorResourceModel) Convert() (*kuma.Monitor, diag.Diagnostics) {
+	monitor, err := m.MonitorBaseModel.Convert()
+	if err.HasError() {
+		return nil, err
+	}
+
+	monitor.Hostname = m.Hostname.ValueString()
+	monitor.Port = m.Port.ValueInt64()
+
+	return monitor, nil
+}
+
+func (m *TcpPortMonitorResourceModel) ConvertFrom(stu kuma.Monitor) diag.Diagnostics {
+	m.Hostname = types.StringValue(stu.Hostname)
+	m.Port = types.Int64Value(stu.Port)
+
+	return m.MonitorBaseModel.ConvertFrom(stu)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &tcpPortMonitorResource{}
+var _ resource.ResourceWithImportState = &tcpPortMonitorResource{}
+var _ resource.ResourceWithModifyPlan = &tcpPortMonitorResource{}
+
+func NewTcpPortMonitorResource() resource.Resource {
+	return &tcpPortMonitorResource{}
+}
+
+// tcpPortMonitorResource manages monitors that open a TCP connection.
+type tcpPortMonitorResource struct {
+	monitorResource[TcpPortMonitorResourceModel, *TcpPortMonitorResourceModel]
+}
+
+func (r *tcpPortMonitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tcp_port_monitor"
+}
+
+func (r *tcpPortMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a monitor that is up when a TCP connection to the port can be opened.",
+
+		Attributes: monitorAttributes("port", map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Hostname or IP address to connect to.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "TCP port to connect to.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+		}),
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"terraform-provider-kuma/internal/kuma"
+	"terraform-provider-kuma/internal/kuma/kumatest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccTcpPortMonitorResource(t *testing.T) {
+	server := kumatest.NewServer(t)
+	server.AddTag(kuma.Tag{Name: "env", Color: "#2563EB"})
+	defaultNotification := server.AddNotification(kuma.Notification{Name: "ops", Type: "slack", Active: true, IsDefault: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorsDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_tcp_port_monitor" "test" {
+  name     = "postgres"
+  hostname = "db.internal"
+  port     = 70000
+}
+`,
+				ExpectError: regexp.MustCompile(`Attribute port value must be between 1 and 65535`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_group" "databases" {
+  name = "databases"
+}
+
+resource "kuma_tcp_port_monitor" "test" {
+  name     = "postgres"
+  hostname = "db.internal"
+  port     = 5432
+  parent   = kuma_group.databases.id
+  interval = 30
+
+  tags = {
+    env = "prod"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "type", "port"),
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "hostname", "db.internal"),
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "port", "5432"),
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "interval", "30"),
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "notification_list.0", strconv.FormatInt(defaultNotification.ID, 10)),
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "tags.env", "prod"),
+					resource.TestCheckResourceAttrPair("kuma_tcp_port_monitor.test", "parent", "kuma_group.databases", "id"),
+					testAccCheckMonitor(server, "kuma_tcp_port_monitor.test", func(monitor kuma.Monitor) error {
+						if monitor.Type != "port" || monitor.Hostname != "db.internal" || monitor.Port != 5432 {
+							return fmt.Errorf("unexpected monitor: %+v", monitor)
+						}
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kuma_tcp_port_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place
+			{
+				Config: testAccProviderConfig(server) + `
+resource "kuma_group" "databases" {
+  name = "databases"
+}
+
+resource "kuma_tcp_port_monitor" "test" {
+  name     = "postgres"
+  hostname = "db-replica.internal"
+  port     = 6432
+  parent   = kuma_group.databases.id
+  interval = 30
+
+  tags = {
+    env = "prod"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kuma_tcp_port_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "hostname", "db-replica.internal"),
+					resource.TestCheckResourceAttr("kuma_tcp_port_monitor.test", "port", "6432"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
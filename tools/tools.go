@@ -8,4 +8,7 @@ package tools
 import (
 	// Documentation generation
 	_ "github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs"
+
+	// Mock generation
+	_ "go.uber.org/mock/mockgen"
 )